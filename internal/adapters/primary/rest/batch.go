@@ -0,0 +1,269 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+	"github.com/sean-rowe/weather-service/internal/middleware"
+)
+
+// Batch defaults applied when HandlerConfig leaves a setting at zero.
+const (
+	defaultBatchMaxItems    = 500
+	defaultBatchConcurrency = 10
+	defaultBatchItemCost    = 0.2
+
+	// maxBatchBodyBytes limits the size of a batch request body
+	maxBatchBodyBytes = 1 << 20
+)
+
+// BatchItemRequest is one location in a batch weather request.
+// Pointers distinguish missing coordinates from zero values.
+type BatchItemRequest struct {
+	Latitude  *float64 `json:"lat"`
+	Longitude *float64 `json:"lon"`
+}
+
+// BatchResponse represents the JSON structure returned by the batch weather endpoint.
+// Results are in request order; each carries either weather or an error.
+// The response carries the stale Warning header if any result is stale.
+type BatchResponse struct {
+	Results   []BatchItemResponse `json:"results"`
+	Succeeded int                 `json:"succeeded"`
+	Failed    int                 `json:"failed"`
+}
+
+// BatchItemResponse is the outcome of one location in a batch.
+type BatchItemResponse struct {
+	Index     int              `json:"index"`
+	Latitude  float64          `json:"latitude"`
+	Longitude float64          `json:"longitude"`
+	Weather   *WeatherResponse `json:"weather,omitempty"`
+	Error     *ErrorResponse   `json:"error,omitempty"`
+}
+
+// GetWeatherBatch handles POST requests for weather at many locations.
+// Locations are looked up concurrently, up to the configured cap, and a
+// failure for one location is reported in its result without failing the batch.
+// Once the request is cancelled no further lookups start, and the remaining
+// locations report REQUEST_CANCELLED.
+//
+// Parameters:
+//   - w: HTTP response writer
+//   - r: HTTP request with a JSON array of {"lat", "lon"} objects and optional
+//     'units' and 'profile' query parameters applied to every location
+//
+// Response codes:
+//   - 200: BatchResponse JSON with per-location results or error codes
+//   - 400: Invalid request (INVALID_UNITS, INVALID_BATCH, BATCH_TOO_LARGE)
+func (h *WeatherHandler) GetWeatherBatch(w http.ResponseWriter, r *http.Request) {
+	units, ok := h.parseUnits(w, r)
+
+	if !ok {
+		return
+	}
+
+	var items []BatchItemRequest
+
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBatchBodyBytes)).Decode(&items); err != nil {
+		h.respondWithError(
+			w,
+			http.StatusBadRequest,
+			"INVALID_BATCH",
+			"The request body must be a JSON array of objects with 'lat' and 'lon'",
+		)
+
+		return
+	}
+
+	if len(items) == 0 {
+		h.respondWithError(w, http.StatusBadRequest, "INVALID_BATCH", "The batch must contain at least one location")
+		return
+	}
+
+	if len(items) > h.batchMaxItems {
+		h.respondWithError(
+			w,
+			http.StatusBadRequest,
+			"BATCH_TOO_LARGE",
+			fmt.Sprintf("The batch may contain at most %d locations", h.batchMaxItems),
+		)
+
+		return
+	}
+
+	ctx := r.Context()
+	profile := h.requestProfile(r)
+	results := make([]BatchItemResponse, len(items))
+	sem := make(chan struct{}, h.batchConcurrency)
+
+	var wg sync.WaitGroup
+
+	for i, item := range items {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		// Lookups detach their upstream fetches, so a slot freed by a cancelled lookup
+		// must not start another one once the client has gone
+		if ctx.Err() != nil {
+			for j := i; j < len(items); j++ {
+				results[j] = cancelledBatchItem(j, items[j])
+			}
+
+			break
+		}
+
+		wg.Add(1)
+
+		go func(i int, item BatchItemRequest) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results[i] = h.lookupBatchItem(ctx, i, item, profile, units)
+		}(i, item)
+	}
+
+	wg.Wait()
+
+	response := BatchResponse{Results: results}
+
+	for _, result := range results {
+		if result.Error != nil {
+			response.Failed++
+		} else {
+			response.Succeeded++
+			markStale(w, result.Weather.Stale)
+		}
+	}
+
+	h.logger.Info("batch weather retrieved",
+		zap.Int("items", len(items)),
+		zap.Int("failed", response.Failed),
+		zap.String("request_id", middleware.GetRequestID(r.Context())),
+	)
+
+	h.respondWithJSON(w, http.StatusOK, response)
+}
+
+// lookupBatchItem retrieves weather for one batch location.
+//
+// Parameters:
+//   - ctx: Request context
+//   - index: Position of the item in the batch
+//   - item: Requested location
+//   - profile: Categorization profile name (empty selects the default)
+//   - units: Requested unit system, or empty to keep provider units
+//
+// Returns:
+//   - BatchItemResponse: Weather on success, otherwise the mapped error code and message
+func (h *WeatherHandler) lookupBatchItem(ctx context.Context, index int, item BatchItemRequest, profile string, units domain.UnitSystem) BatchItemResponse {
+	result := BatchItemResponse{Index: index}
+
+	if item.Latitude == nil || item.Longitude == nil {
+		result.Error = &ErrorResponse{
+			Error:   "MISSING_PARAMETERS",
+			Message: "Each location requires 'lat' and 'lon'",
+		}
+
+		return result
+	}
+
+	result.Latitude = *item.Latitude
+	result.Longitude = *item.Longitude
+
+	coords := domain.Coordinates{Latitude: *item.Latitude, Longitude: *item.Longitude}
+	weather, err := h.service.GetWeather(ctx, coords, profile)
+
+	if err != nil {
+		var e *domain.WeatherError
+
+		if !errors.As(err, &e) {
+			h.logger.Error("unexpected batch item error",
+				zap.Int("index", index),
+				zap.Error(err),
+			)
+		}
+
+		_, code, message := serviceErrorResponse(err)
+		result.Error = &ErrorResponse{Error: code, Message: message}
+
+		return result
+	}
+
+	response := toWeatherResponse(weather, units)
+	result.Weather = &response
+
+	return result
+}
+
+// cancelledBatchItem reports a batch location that was not looked up because the
+// request was cancelled first.
+//
+// Parameters:
+//   - index: Position of the item in the batch
+//   - item: Requested location
+//
+// Returns:
+//   - BatchItemResponse: Result carrying a REQUEST_CANCELLED error
+func cancelledBatchItem(index int, item BatchItemRequest) BatchItemResponse {
+	result := BatchItemResponse{
+		Index: index,
+		Error: &ErrorResponse{
+			Error:   "REQUEST_CANCELLED",
+			Message: "The request was cancelled before this location was looked up",
+		},
+	}
+
+	if item.Latitude != nil && item.Longitude != nil {
+		result.Latitude = *item.Latitude
+		result.Longitude = *item.Longitude
+	}
+
+	return result
+}
+
+// BatchCost returns the rate-limit cost of a batch request: the number of
+// locations times the configured per-item cost, rounded up. The body is read
+// and restored so the handler can decode it again. Malformed or oversized
+// batches cost one unit since the handler rejects them without any lookups.
+//
+// Parameters:
+//   - r: Batch weather request
+//
+// Returns:
+//   - int: Rate-limit units the request consumes
+func (h *WeatherHandler) BatchCost(r *http.Request) int {
+	if r.Body == nil {
+		return 1
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBatchBodyBytes+1))
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		return 1
+	}
+
+	var items []json.RawMessage
+
+	if err := json.Unmarshal(body, &items); err != nil || len(items) == 0 || len(items) > h.batchMaxItems {
+		return 1
+	}
+
+	// Round away floating-point noise first so that e.g. 15 * 0.2 costs 3, not 4
+	cost := math.Round(float64(len(items))*h.batchItemCost*1e6) / 1e6
+
+	return int(math.Max(1, math.Ceil(cost)))
+}
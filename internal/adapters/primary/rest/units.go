@@ -0,0 +1,173 @@
+package rest
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+)
+
+// parseUnits extracts and validates the optional 'units' query parameter.
+// When parsing fails it writes the error response and returns false.
+//
+// Parameters:
+//   - w: HTTP response writer used to report parameter errors
+//   - r: HTTP request containing the optional 'units' query parameter
+//
+// Returns:
+//   - domain.UnitSystem: Requested unit system, or empty to keep provider units
+//   - bool: true if parsing succeeded, false if an error response was written
+func (h *WeatherHandler) parseUnits(w http.ResponseWriter, r *http.Request) (domain.UnitSystem, bool) {
+	unitsStr := r.URL.Query().Get("units")
+
+	if unitsStr == "" {
+		return "", true
+	}
+
+	units, err := domain.ParseUnitSystem(unitsStr)
+
+	if err != nil {
+		h.respondWithError(
+			w,
+			http.StatusBadRequest,
+			"INVALID_UNITS",
+			"The 'units' parameter must be one of: metric, imperial, si",
+		)
+
+		return "", false
+	}
+
+	return units, true
+}
+
+// convertTemperature expresses a temperature in the requested unit system.
+//
+// Parameters:
+//   - t: Temperature as stored by the service
+//   - units: Requested unit system, or empty to keep the original unit
+//
+// Returns:
+//   - domain.Temperature: Converted temperature rounded to two decimal places
+func convertTemperature(t domain.Temperature, units domain.UnitSystem) domain.Temperature {
+	if units == "" {
+		return t
+	}
+
+	converted := t.Convert(units.TemperatureUnit())
+	converted.Value = roundMeasurement(converted.Value)
+
+	return converted
+}
+
+// temperatureResponse maps an optional temperature to its JSON representation.
+//
+// Parameters:
+//   - t: Temperature to convert, may be nil
+//   - units: Requested unit system, or empty to keep the original unit
+//
+// Returns:
+//   - *MeasurementResponse: Converted measurement, or nil if t is nil
+func temperatureResponse(t *domain.Temperature, units domain.UnitSystem) *MeasurementResponse {
+	if t == nil {
+		return nil
+	}
+
+	converted := convertTemperature(*t, units)
+
+	return &MeasurementResponse{Value: converted.Value, Unit: string(converted.Unit)}
+}
+
+// speedResponse maps an optional speed to its JSON representation.
+//
+// Parameters:
+//   - s: Speed to convert, may be nil
+//   - units: Requested unit system, or empty to keep the original unit
+//
+// Returns:
+//   - *MeasurementResponse: Converted measurement, or nil if s is nil
+func speedResponse(s *domain.Speed, units domain.UnitSystem) *MeasurementResponse {
+	if s == nil {
+		return nil
+	}
+
+	converted := *s
+
+	if units != "" {
+		converted = s.Convert(units.SpeedUnit())
+		converted.Value = roundMeasurement(converted.Value)
+	}
+
+	return &MeasurementResponse{Value: converted.Value, Unit: string(converted.Unit)}
+}
+
+// pressureResponse maps an optional pressure to its JSON representation.
+//
+// Parameters:
+//   - p: Pressure to convert, may be nil
+//   - units: Requested unit system, or empty to keep the original unit
+//
+// Returns:
+//   - *MeasurementResponse: Converted measurement, or nil if p is nil
+func pressureResponse(p *domain.Pressure, units domain.UnitSystem) *MeasurementResponse {
+	if p == nil {
+		return nil
+	}
+
+	converted := *p
+
+	if units != "" {
+		converted = p.Convert(units.PressureUnit())
+		converted.Value = roundMeasurement(converted.Value)
+	}
+
+	return &MeasurementResponse{Value: converted.Value, Unit: string(converted.Unit)}
+}
+
+// distanceResponse maps an optional distance to its JSON representation.
+//
+// Parameters:
+//   - d: Distance to convert, may be nil
+//   - units: Requested unit system, or empty to keep the original unit
+//
+// Returns:
+//   - *MeasurementResponse: Converted measurement, or nil if d is nil
+func distanceResponse(d *domain.Distance, units domain.UnitSystem) *MeasurementResponse {
+	if d == nil {
+		return nil
+	}
+
+	converted := *d
+
+	if units != "" {
+		converted = d.Convert(units.DistanceUnit())
+		converted.Value = roundMeasurement(converted.Value)
+	}
+
+	return &MeasurementResponse{Value: converted.Value, Unit: string(converted.Unit)}
+}
+
+// windResponse maps an optional forecast wind to its JSON representation.
+//
+// Parameters:
+//   - wind: Wind speed range and direction, may be nil
+//   - units: Requested unit system, or empty to keep the original unit
+//
+// Returns:
+//   - *WindResponse: Converted wind, or nil if wind is nil
+func windResponse(wind *domain.Wind, units domain.UnitSystem) *WindResponse {
+	if wind == nil {
+		return nil
+	}
+
+	return &WindResponse{
+		MinSpeed:  *speedResponse(&wind.MinSpeed, units),
+		MaxSpeed:  *speedResponse(&wind.MaxSpeed, units),
+		Direction: wind.Direction,
+	}
+}
+
+// roundMeasurement rounds a converted value to two decimal places so that
+// conversions do not expose floating-point noise to clients.
+func roundMeasurement(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -23,34 +24,133 @@ type WeatherHandler struct {
 	// service provides access to weather business operations
 	service ports.WeatherService
 
+	// locations resolves place names to coordinates (nil disables location queries)
+	locations ports.LocationService
+
+	// apiKeyProfiles maps API keys to their default categorization profile
+	apiKeyProfiles map[string]string
+
+	// batchMaxItems is the largest number of coordinates accepted in one batch
+	batchMaxItems int
+
+	// batchConcurrency caps the number of batch items looked up at once
+	batchConcurrency int
+
+	// batchItemCost is the rate-limit cost charged per batch item
+	batchItemCost float64
+
 	// logger records request processing events and errors
 	logger *zap.Logger
 }
 
+// HandlerConfig contains optional settings for the weather handler.
+// Zero values select the defaults.
+type HandlerConfig struct {
+	// APIKeyProfiles maps API keys to their default categorization profile
+	APIKeyProfiles map[string]string
+
+	// BatchMaxItems is the largest number of coordinates accepted in one batch (default: 500)
+	BatchMaxItems int
+
+	// BatchConcurrency caps the number of batch items looked up at once (default: 10)
+	BatchConcurrency int
+
+	// BatchItemCost is the rate-limit cost charged per batch item (default: 0.2)
+	BatchItemCost float64
+}
+
 // NewWeatherHandler creates a new HTTP handler for weather operations.
 //
 // Parameters:
 //   - service: WeatherService interface for business logic operations
+//   - locations: LocationService for 'q', 'zip' and 'city'/'state' queries (can be nil)
+//   - cfg: Handler configuration; zero values select the defaults
 //   - logger: Zap logger for request logging and error tracking
 //
 // Returns:
 //   - *WeatherHandler: Configured handler instance
-func NewWeatherHandler(service ports.WeatherService, logger *zap.Logger) *WeatherHandler {
+func NewWeatherHandler(service ports.WeatherService, locations ports.LocationService, cfg HandlerConfig, logger *zap.Logger) *WeatherHandler {
+	if cfg.BatchMaxItems <= 0 {
+		cfg.BatchMaxItems = defaultBatchMaxItems
+	}
+
+	if cfg.BatchConcurrency <= 0 {
+		cfg.BatchConcurrency = defaultBatchConcurrency
+	}
+
+	if cfg.BatchItemCost <= 0 {
+		cfg.BatchItemCost = defaultBatchItemCost
+	}
+
 	return &WeatherHandler{
-		service: service,
-		logger:  logger,
+		service:          service,
+		locations:        locations,
+		apiKeyProfiles:   cfg.APIKeyProfiles,
+		batchMaxItems:    cfg.BatchMaxItems,
+		batchConcurrency: cfg.BatchConcurrency,
+		batchItemCost:    cfg.BatchItemCost,
+		logger:           logger,
 	}
 }
 
+// apiKeyHeader is the request header that carries the client's API key.
+const apiKeyHeader = "X-API-Key"
+
 // WeatherResponse represents the JSON structure returned by weather endpoints.
 // This DTO maps domain objects to a client-friendly format with consistent field naming.
+// Optional forecast details are omitted when the provider did not supply them.
+// Stale and DataAge (seconds since the data was fetched) are set only when the
+// data was served from cache past its TTL. GeneratedAt and UpdatedAt are the
+// provider's generation and last update times of the forecast, when it reports them.
 type WeatherResponse struct {
-	Latitude        float64 `json:"latitude"`
-	Longitude       float64 `json:"longitude"`
-	Forecast        string  `json:"forecast"`
-	Temperature     float64 `json:"temperature"`
-	TemperatureUnit string  `json:"temperatureUnit"`
-	Category        string  `json:"category"`
+	Latitude                 float64                `json:"latitude"`
+	Longitude                float64                `json:"longitude"`
+	Location                 *LocationResponse      `json:"location,omitempty"`
+	Forecast                 string                 `json:"forecast"`
+	DetailedForecast         string                 `json:"detailedForecast,omitempty"`
+	Temperature              float64                `json:"temperature"`
+	TemperatureUnit          string                 `json:"temperatureUnit"`
+	Wind                     *WindResponse          `json:"wind,omitempty"`
+	PrecipitationProbability *float64               `json:"precipitationProbability,omitempty"`
+	RelativeHumidity         *float64               `json:"relativeHumidity,omitempty"`
+	Dewpoint                 *MeasurementResponse   `json:"dewpoint,omitempty"`
+	FeelsLike                *MeasurementResponse   `json:"feelsLike,omitempty"`
+	Icon                     string                 `json:"icon,omitempty"`
+	Category                 string                 `json:"category"`
+	Profile                  string                 `json:"profile"`
+	Provider                 string                 `json:"provider,omitempty"`
+	Stale                    bool                   `json:"stale,omitempty"`
+	DataAge                  int64                  `json:"dataAge,omitempty"`
+	GeneratedAt              *time.Time             `json:"generatedAt,omitempty"`
+	UpdatedAt                *time.Time             `json:"updatedAt,omitempty"`
+	Alerts                   []AlertSummaryResponse `json:"alerts"`
+}
+
+// WindResponse represents a forecast wind speed range and direction.
+type WindResponse struct {
+	MinSpeed  MeasurementResponse `json:"minSpeed"`
+	MaxSpeed  MeasurementResponse `json:"maxSpeed"`
+	Direction string              `json:"direction,omitempty"`
+}
+
+// AlertSummaryResponse represents the condensed alert embedded in WeatherResponse.
+type AlertSummaryResponse struct {
+	Event    string    `json:"event"`
+	Severity string    `json:"severity"`
+	Urgency  string    `json:"urgency"`
+	Headline string    `json:"headline"`
+	Expires  time.Time `json:"expires"`
+}
+
+// LocationResponse describes the place a location query resolved to.
+// The coordinates are those used for the weather lookup.
+type LocationResponse struct {
+	Name       string  `json:"name"`
+	State      string  `json:"state,omitempty"`
+	PostalCode string  `json:"postalCode,omitempty"`
+	Latitude   float64 `json:"latitude"`
+	Longitude  float64 `json:"longitude"`
+	Source     string  `json:"source"`
 }
 
 // ErrorResponse represents a standardized error response structure.
@@ -59,18 +159,637 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// ForecastResponse represents the JSON structure returned by the multi-day forecast endpoint.
+type ForecastResponse struct {
+	Latitude    float64                  `json:"latitude"`
+	Longitude   float64                  `json:"longitude"`
+	Location    *LocationResponse        `json:"location,omitempty"`
+	Profile     string                   `json:"profile"`
+	Provider    string                   `json:"provider,omitempty"`
+	Stale       bool                     `json:"stale,omitempty"`
+	DataAge     int64                    `json:"dataAge,omitempty"`
+	GeneratedAt *time.Time               `json:"generatedAt,omitempty"`
+	UpdatedAt   *time.Time               `json:"updatedAt,omitempty"`
+	Periods     []ForecastPeriodResponse `json:"periods"`
+}
+
+// ForecastPeriodResponse represents a single named forecast period.
+type ForecastPeriodResponse struct {
+	Name                     string               `json:"name"`
+	StartTime                time.Time            `json:"startTime"`
+	EndTime                  time.Time            `json:"endTime"`
+	IsDaytime                bool                 `json:"isDaytime"`
+	Temperature              float64              `json:"temperature"`
+	TemperatureUnit          string               `json:"temperatureUnit"`
+	Wind                     *WindResponse        `json:"wind,omitempty"`
+	PrecipitationProbability *float64             `json:"precipitationProbability,omitempty"`
+	RelativeHumidity         *float64             `json:"relativeHumidity,omitempty"`
+	Dewpoint                 *MeasurementResponse `json:"dewpoint,omitempty"`
+	Icon                     string               `json:"icon,omitempty"`
+	ShortForecast            string               `json:"shortForecast"`
+	DetailedForecast         string               `json:"detailedForecast"`
+	Category                 string               `json:"category"`
+}
+
+// HourlyForecastResponse represents the JSON structure returned by the hourly forecast endpoint.
+type HourlyForecastResponse struct {
+	Latitude    float64                  `json:"latitude"`
+	Longitude   float64                  `json:"longitude"`
+	Location    *LocationResponse        `json:"location,omitempty"`
+	Profile     string                   `json:"profile"`
+	Provider    string                   `json:"provider,omitempty"`
+	Stale       bool                     `json:"stale,omitempty"`
+	DataAge     int64                    `json:"dataAge,omitempty"`
+	GeneratedAt *time.Time               `json:"generatedAt,omitempty"`
+	UpdatedAt   *time.Time               `json:"updatedAt,omitempty"`
+	Periods     []ForecastPeriodResponse `json:"periods"`
+}
+
+// ObservationResponse represents the JSON structure returned by the observations endpoint.
+// Optional measurements are omitted when the station did not report them.
+type ObservationResponse struct {
+	Latitude         float64              `json:"latitude"`
+	Longitude        float64              `json:"longitude"`
+	Location         *LocationResponse    `json:"location,omitempty"`
+	StationID        string               `json:"stationId"`
+	StationName      string               `json:"stationName"`
+	StationDistance  MeasurementResponse  `json:"stationDistance"`
+	ObservedAt       time.Time            `json:"observedAt"`
+	Description      string               `json:"description,omitempty"`
+	Temperature      float64              `json:"temperature"`
+	TemperatureUnit  string               `json:"temperatureUnit"`
+	Dewpoint         *MeasurementResponse `json:"dewpoint,omitempty"`
+	RelativeHumidity *float64             `json:"relativeHumidity,omitempty"`
+	WindSpeed        *MeasurementResponse `json:"windSpeed,omitempty"`
+	WindDirection    *float64             `json:"windDirection,omitempty"`
+	Pressure         *MeasurementResponse `json:"pressure,omitempty"`
+	Visibility       *MeasurementResponse `json:"visibility,omitempty"`
+	Category         string               `json:"category"`
+	Profile          string               `json:"profile"`
+	Provider         string               `json:"provider,omitempty"`
+	Stale            bool                 `json:"stale,omitempty"`
+	DataAge          int64                `json:"dataAge,omitempty"`
+}
+
+// AlertsResponse represents the JSON structure returned by the alerts endpoint.
+type AlertsResponse struct {
+	Latitude  float64           `json:"latitude"`
+	Longitude float64           `json:"longitude"`
+	Location  *LocationResponse `json:"location,omitempty"`
+	Stale     bool              `json:"stale,omitempty"`
+	DataAge   int64             `json:"dataAge,omitempty"`
+	Alerts    []AlertResponse   `json:"alerts"`
+}
+
+// AlertResponse represents a single active weather alert.
+type AlertResponse struct {
+	ID              string     `json:"id"`
+	Event           string     `json:"event"`
+	Severity        string     `json:"severity"`
+	Urgency         string     `json:"urgency"`
+	Certainty       string     `json:"certainty"`
+	Headline        string     `json:"headline"`
+	Description     string     `json:"description"`
+	Instruction     string     `json:"instruction,omitempty"`
+	AreaDescription string     `json:"areaDescription"`
+	AffectedZones   []string   `json:"affectedZones"`
+	Onset           *time.Time `json:"onset,omitempty"`
+	Expires         time.Time  `json:"expires"`
+}
+
+// MeasurementResponse represents a numeric measurement paired with its unit.
+type MeasurementResponse struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"`
+}
+
+// maxForecastHours is the largest 'hours' value accepted by the hourly forecast endpoint.
+// NWS publishes roughly 156 hours (6.5 days) of hourly data.
+const maxForecastHours = 156
+
 // GetWeather handles GET requests for weather information.
 //
 // Parameters:
 //   - w: HTTP response writer
-//   - r: HTTP request containing 'lat' and 'lon' query parameters
+//   - r: HTTP request containing 'lat' and 'lon' (or 'q', 'zip', or 'city' and 'state') and optional 'units' and 'profile' query parameters
 //
 // Response codes:
 //   - 200: Success with WeatherResponse JSON
-//   - 400: Invalid parameters (MISSING_PARAMETERS, INVALID_LATITUDE, INVALID_LONGITUDE)
-//   - 503: Service unavailable (FORECAST_RETRIEVAL_ERROR)
+//   - 400: Invalid parameters (MISSING_PARAMETERS, INVALID_LATITUDE, INVALID_LONGITUDE, INVALID_UNITS, INVALID_PROFILE, INVALID_LOCATION)
+//   - 404: Location query matched no place (LOCATION_NOT_FOUND)
+//   - 422: No weather provider serves the location (LOCATION_NOT_SUPPORTED)
+//   - 503: Service unavailable (FORECAST_RETRIEVAL_ERROR, GEOCODING_ERROR)
 //   - 500: Internal server error
 func (h *WeatherHandler) GetWeather(w http.ResponseWriter, r *http.Request) {
+	coords, place, ok := h.resolveLocation(w, r)
+
+	if !ok {
+		return
+	}
+
+	units, ok := h.parseUnits(w, r)
+
+	if !ok {
+		return
+	}
+
+	weather, err := h.service.GetWeather(r.Context(), coords, h.requestProfile(r))
+
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	response := toWeatherResponse(weather, units)
+	response.Location = locationResponse(place)
+
+	markStale(w, weather.Stale)
+	h.respondWithJSON(w, http.StatusOK, response)
+}
+
+// GetForecast handles GET requests for the multi-day forecast.
+//
+// Parameters:
+//   - w: HTTP response writer
+//   - r: HTTP request containing 'lat' and 'lon' (or 'q', 'zip', or 'city' and 'state') and optional 'units' and 'profile' query parameters
+//
+// Response codes:
+//   - 200: Success with ForecastResponse JSON
+//   - 400: Invalid parameters (MISSING_PARAMETERS, INVALID_LATITUDE, INVALID_LONGITUDE, INVALID_UNITS, INVALID_PROFILE, INVALID_LOCATION)
+//   - 404: Location query matched no place (LOCATION_NOT_FOUND)
+//   - 422: No weather provider serves the location (LOCATION_NOT_SUPPORTED)
+//   - 503: Service unavailable (FORECAST_RETRIEVAL_ERROR, GEOCODING_ERROR)
+//   - 500: Internal server error
+func (h *WeatherHandler) GetForecast(w http.ResponseWriter, r *http.Request) {
+	coords, place, ok := h.resolveLocation(w, r)
+
+	if !ok {
+		return
+	}
+
+	units, ok := h.parseUnits(w, r)
+
+	if !ok {
+		return
+	}
+
+	forecast, err := h.service.GetForecast(r.Context(), coords, h.requestProfile(r))
+
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	response := ForecastResponse{
+		Latitude:    forecast.Coordinates.Latitude,
+		Longitude:   forecast.Coordinates.Longitude,
+		Location:    locationResponse(place),
+		Profile:     forecast.Profile,
+		Provider:    forecast.Provider,
+		Stale:       forecast.Stale,
+		DataAge:     dataAge(forecast.Stale, forecast.FetchedAt),
+		GeneratedAt: optionalTime(forecast.GeneratedAt),
+		UpdatedAt:   optionalTime(forecast.UpdatedAt),
+		Periods:     toPeriodResponses(forecast.Periods, units),
+	}
+
+	markStale(w, forecast.Stale)
+	h.respondWithJSON(w, http.StatusOK, response)
+}
+
+// GetHourlyForecast handles GET requests for the hourly forecast.
+//
+// Parameters:
+//   - w: HTTP response writer
+//   - r: HTTP request containing 'lat' and 'lon' (or 'q', 'zip', or 'city' and 'state') and optional 'hours', 'units' and 'profile' query parameters
+//
+// Response codes:
+//   - 200: Success with HourlyForecastResponse JSON
+//   - 400: Invalid parameters (MISSING_PARAMETERS, INVALID_LATITUDE, INVALID_LONGITUDE, INVALID_HOURS, INVALID_UNITS, INVALID_PROFILE, INVALID_LOCATION)
+//   - 404: Location query matched no place (LOCATION_NOT_FOUND)
+//   - 422: No weather provider serves the location (LOCATION_NOT_SUPPORTED)
+//   - 503: Service unavailable (FORECAST_RETRIEVAL_ERROR, GEOCODING_ERROR)
+//   - 500: Internal server error
+func (h *WeatherHandler) GetHourlyForecast(w http.ResponseWriter, r *http.Request) {
+	coords, place, ok := h.resolveLocation(w, r)
+
+	if !ok {
+		return
+	}
+
+	units, ok := h.parseUnits(w, r)
+
+	if !ok {
+		return
+	}
+
+	hours := 0
+
+	if hoursStr := r.URL.Query().Get("hours"); hoursStr != "" {
+		parsed, err := strconv.Atoi(hoursStr)
+
+		if err != nil || parsed < 1 || parsed > maxForecastHours {
+			h.respondWithError(
+				w,
+				http.StatusBadRequest,
+				"INVALID_HOURS",
+				"The 'hours' parameter must be an integer between 1 and 156",
+			)
+
+			return
+		}
+
+		hours = parsed
+	}
+
+	forecast, err := h.service.GetHourlyForecast(r.Context(), coords, hours, h.requestProfile(r))
+
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	response := HourlyForecastResponse{
+		Latitude:    forecast.Coordinates.Latitude,
+		Longitude:   forecast.Coordinates.Longitude,
+		Location:    locationResponse(place),
+		Profile:     forecast.Profile,
+		Provider:    forecast.Provider,
+		Stale:       forecast.Stale,
+		DataAge:     dataAge(forecast.Stale, forecast.FetchedAt),
+		GeneratedAt: optionalTime(forecast.GeneratedAt),
+		UpdatedAt:   optionalTime(forecast.UpdatedAt),
+		Periods:     toPeriodResponses(forecast.Periods, units),
+	}
+
+	markStale(w, forecast.Stale)
+	h.respondWithJSON(w, http.StatusOK, response)
+}
+
+// GetObservation handles GET requests for the latest observed conditions.
+//
+// Parameters:
+//   - w: HTTP response writer
+//   - r: HTTP request containing 'lat' and 'lon' (or 'q', 'zip', or 'city' and 'state') and optional 'units' and 'profile' query parameters
+//
+// Response codes:
+//   - 200: Success with ObservationResponse JSON
+//   - 400: Invalid parameters (MISSING_PARAMETERS, INVALID_LATITUDE, INVALID_LONGITUDE, INVALID_UNITS, INVALID_PROFILE, INVALID_LOCATION)
+//   - 404: Location query matched no place (LOCATION_NOT_FOUND)
+//   - 422: No weather provider serves the location (LOCATION_NOT_SUPPORTED)
+//   - 503: Service unavailable (OBSERVATION_RETRIEVAL_ERROR, GEOCODING_ERROR)
+//   - 500: Internal server error
+func (h *WeatherHandler) GetObservation(w http.ResponseWriter, r *http.Request) {
+	coords, place, ok := h.resolveLocation(w, r)
+
+	if !ok {
+		return
+	}
+
+	units, ok := h.parseUnits(w, r)
+
+	if !ok {
+		return
+	}
+
+	observation, err := h.service.GetObservation(r.Context(), coords, h.requestProfile(r))
+
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	temperature := convertTemperature(observation.Temperature, units)
+
+	response := ObservationResponse{
+		Latitude:         observation.Coordinates.Latitude,
+		Longitude:        observation.Coordinates.Longitude,
+		Location:         locationResponse(place),
+		StationID:        observation.StationID,
+		StationName:      observation.StationName,
+		StationDistance:  *distanceResponse(&observation.StationDistance, units),
+		ObservedAt:       observation.ObservedAt,
+		Description:      observation.Description,
+		Temperature:      temperature.Value,
+		TemperatureUnit:  string(temperature.Unit),
+		Dewpoint:         temperatureResponse(observation.Dewpoint, units),
+		RelativeHumidity: observation.RelativeHumidity,
+		WindSpeed:        speedResponse(observation.WindSpeed, units),
+		WindDirection:    observation.WindDirection,
+		Pressure:         pressureResponse(observation.Pressure, units),
+		Visibility:       distanceResponse(observation.Visibility, units),
+		Category:         string(observation.Category),
+		Profile:          observation.Profile,
+		Provider:         observation.Provider,
+		Stale:            observation.Stale,
+		DataAge:          dataAge(observation.Stale, observation.FetchedAt),
+	}
+
+	markStale(w, observation.Stale)
+	h.respondWithJSON(w, http.StatusOK, response)
+}
+
+// GetAlerts handles GET requests for active weather alerts.
+//
+// Parameters:
+//   - w: HTTP response writer
+//   - r: HTTP request containing 'lat' and 'lon' (or 'q', 'zip', or 'city' and 'state') query parameters
+//
+// Response codes:
+//   - 200: Success with AlertsResponse JSON (empty list when no alerts are active)
+//   - 400: Invalid parameters (MISSING_PARAMETERS, INVALID_LATITUDE, INVALID_LONGITUDE, INVALID_LOCATION)
+//   - 404: Location query matched no place (LOCATION_NOT_FOUND)
+//   - 422: No weather provider serves the location (LOCATION_NOT_SUPPORTED)
+//   - 503: Service unavailable (ALERTS_RETRIEVAL_ERROR, GEOCODING_ERROR)
+//   - 500: Internal server error
+func (h *WeatherHandler) GetAlerts(w http.ResponseWriter, r *http.Request) {
+	coords, place, ok := h.resolveLocation(w, r)
+
+	if !ok {
+		return
+	}
+
+	report, err := h.service.GetAlerts(r.Context(), coords)
+
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	response := AlertsResponse{
+		Latitude:  report.Coordinates.Latitude,
+		Longitude: report.Coordinates.Longitude,
+		Location:  locationResponse(place),
+		Stale:     report.Stale,
+		DataAge:   dataAge(report.Stale, report.FetchedAt),
+		Alerts:    make([]AlertResponse, 0, len(report.Alerts)),
+	}
+
+	for _, a := range report.Alerts {
+		alert := AlertResponse{
+			ID:              a.ID,
+			Event:           a.Event,
+			Severity:        string(a.Severity),
+			Urgency:         string(a.Urgency),
+			Certainty:       string(a.Certainty),
+			Headline:        a.Headline,
+			Description:     a.Description,
+			Instruction:     a.Instruction,
+			AreaDescription: a.AreaDescription,
+			AffectedZones:   a.AffectedZones,
+			Expires:         a.Expires,
+		}
+
+		if !a.Onset.IsZero() {
+			onset := a.Onset
+			alert.Onset = &onset
+		}
+
+		response.Alerts = append(response.Alerts, alert)
+	}
+
+	markStale(w, report.Stale)
+	h.respondWithJSON(w, http.StatusOK, response)
+}
+
+// toWeatherResponse maps a weather report to its JSON representation.
+//
+// Parameters:
+//   - weather: Weather report from the service
+//   - units: Requested unit system, or empty to keep provider units
+//
+// Returns:
+//   - WeatherResponse: Response DTO with converted measurements and alert summaries
+func toWeatherResponse(weather *domain.Weather, units domain.UnitSystem) WeatherResponse {
+	temperature := convertTemperature(weather.Temperature, units)
+
+	response := WeatherResponse{
+		Latitude:                 weather.Coordinates.Latitude,
+		Longitude:                weather.Coordinates.Longitude,
+		Forecast:                 weather.Forecast,
+		DetailedForecast:         weather.DetailedForecast,
+		Temperature:              temperature.Value,
+		TemperatureUnit:          string(temperature.Unit),
+		Wind:                     windResponse(weather.Wind, units),
+		PrecipitationProbability: weather.PrecipitationProbability,
+		RelativeHumidity:         weather.RelativeHumidity,
+		Dewpoint:                 temperatureResponse(weather.Dewpoint, units),
+		FeelsLike:                temperatureResponse(weather.FeelsLike, units),
+		Icon:                     weather.Icon,
+		Category:                 string(weather.Category),
+		Profile:                  weather.Profile,
+		Provider:                 weather.Provider,
+		Stale:                    weather.Stale,
+		DataAge:                  dataAge(weather.Stale, weather.FetchedAt),
+		GeneratedAt:              optionalTime(weather.GeneratedAt),
+		UpdatedAt:                optionalTime(weather.UpdatedAt),
+		Alerts:                   make([]AlertSummaryResponse, 0, len(weather.Alerts)),
+	}
+
+	for _, a := range weather.Alerts {
+		response.Alerts = append(response.Alerts, AlertSummaryResponse{
+			Event:    a.Event,
+			Severity: string(a.Severity),
+			Urgency:  string(a.Urgency),
+			Headline: a.Headline,
+			Expires:  a.Expires,
+		})
+	}
+
+	return response
+}
+
+// staleWarning is the Warning header sent with data served from cache past its TTL.
+const staleWarning = `110 - "Response is Stale"`
+
+// markStale adds the stale Warning header to a response carrying stale data.
+//
+// Parameters:
+//   - w: HTTP response writer
+//   - stale: Whether the response data is stale
+func markStale(w http.ResponseWriter, stale bool) {
+	if stale {
+		w.Header().Set("Warning", staleWarning)
+	}
+}
+
+// dataAge reports how old stale data is.
+//
+// Parameters:
+//   - stale: Whether the data is stale
+//   - fetchedAt: When the data was retrieved from the provider
+//
+// Returns:
+//   - int64: Whole seconds since fetchedAt for stale data, or zero for fresh data
+func dataAge(stale bool, fetchedAt time.Time) int64 {
+	if !stale {
+		return 0
+	}
+
+	return int64(time.Since(fetchedAt).Seconds())
+}
+
+// optionalTime returns a pointer to t, or nil when t is zero so the field is omitted.
+//
+// Parameters:
+//   - t: Time to expose
+//
+// Returns:
+//   - *time.Time: Pointer to t, or nil if t is unset
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
+// toPeriodResponses maps domain forecast periods to their JSON representation.
+//
+// Parameters:
+//   - periods: Domain forecast periods
+//   - units: Requested unit system, or empty to keep provider units
+//
+// Returns:
+//   - []ForecastPeriodResponse: Response DTOs in the same order
+func toPeriodResponses(periods []domain.ForecastPeriod, units domain.UnitSystem) []ForecastPeriodResponse {
+	result := make([]ForecastPeriodResponse, 0, len(periods))
+
+	for _, p := range periods {
+		temperature := convertTemperature(p.Temperature, units)
+
+		result = append(result, ForecastPeriodResponse{
+			Name:                     p.Name,
+			StartTime:                p.StartTime,
+			EndTime:                  p.EndTime,
+			IsDaytime:                p.IsDaytime,
+			Temperature:              temperature.Value,
+			TemperatureUnit:          string(temperature.Unit),
+			Wind:                     windResponse(p.Wind, units),
+			PrecipitationProbability: p.PrecipitationProbability,
+			RelativeHumidity:         p.RelativeHumidity,
+			Dewpoint:                 temperatureResponse(p.Dewpoint, units),
+			Icon:                     p.Icon,
+			ShortForecast:            p.ShortForecast,
+			DetailedForecast:         p.DetailedForecast,
+			Category:                 string(p.Category),
+		})
+	}
+
+	return result
+}
+
+// requestProfile determines the categorization profile requested by the client.
+// An explicit 'profile' query parameter wins, followed by the default profile
+// configured for the caller's API key. An empty result selects the service default.
+//
+// Parameters:
+//   - r: HTTP request containing the optional 'profile' parameter and X-API-Key header
+//
+// Returns:
+//   - string: Requested profile name, or empty for the service default
+func (h *WeatherHandler) requestProfile(r *http.Request) string {
+	if profile := r.URL.Query().Get("profile"); profile != "" {
+		return profile
+	}
+
+	if apiKey := r.Header.Get(apiKeyHeader); apiKey != "" {
+		return h.apiKeyProfiles[apiKey]
+	}
+
+	return ""
+}
+
+// resolveLocation determines the coordinates for a request, either from the
+// 'lat' and 'lon' query parameters or by resolving a 'q', 'zip' or
+// 'city'/'state' location query. When resolution fails it writes the error
+// response and returns false.
+//
+// Parameters:
+//   - w: HTTP response writer used to report errors
+//   - r: HTTP request containing coordinates or a location query
+//
+// Returns:
+//   - domain.Coordinates: Coordinates to use for the weather lookup
+//   - *domain.Place: Resolved place, or nil if coordinates were given directly
+//   - bool: true if resolution succeeded, false if an error response was written
+func (h *WeatherHandler) resolveLocation(w http.ResponseWriter, r *http.Request) (domain.Coordinates, *domain.Place, bool) {
+	params := r.URL.Query()
+
+	query := domain.LocationQuery{
+		Text:       params.Get("q"),
+		PostalCode: params.Get("zip"),
+		City:       params.Get("city"),
+		State:      params.Get("state"),
+	}
+
+	if query.IsEmpty() {
+		coords, ok := h.parseCoordinates(w, r)
+
+		return coords, nil, ok
+	}
+
+	if params.Get("lat") != "" || params.Get("lon") != "" {
+		h.respondWithError(
+			w,
+			http.StatusBadRequest,
+			"INVALID_LOCATION",
+			"Use either 'lat' and 'lon' or a location query ('q', 'zip', or 'city' and 'state'), not both",
+		)
+
+		return domain.Coordinates{}, nil, false
+	}
+
+	if h.locations == nil {
+		h.respondWithError(w, http.StatusNotImplemented, "LOCATION_LOOKUP_DISABLED", "Location lookup is not enabled")
+
+		return domain.Coordinates{}, nil, false
+	}
+
+	place, err := h.locations.ResolveLocation(r.Context(), query)
+
+	if err != nil {
+		h.handleServiceError(w, r, err)
+
+		return domain.Coordinates{}, nil, false
+	}
+
+	return place.Coordinates, place, true
+}
+
+// locationResponse maps a resolved place to its JSON representation.
+//
+// Parameters:
+//   - place: Resolved place, may be nil
+//
+// Returns:
+//   - *LocationResponse: Place details, or nil if place is nil
+func locationResponse(place *domain.Place) *LocationResponse {
+	if place == nil {
+		return nil
+	}
+
+	return &LocationResponse{
+		Name:       place.Name,
+		State:      place.State,
+		PostalCode: place.PostalCode,
+		Latitude:   place.Coordinates.Latitude,
+		Longitude:  place.Coordinates.Longitude,
+		Source:     place.Source,
+	}
+}
+
+// parseCoordinates extracts and validates the 'lat' and 'lon' query parameters.
+// When parsing fails it writes the error response and returns false.
+//
+// Parameters:
+//   - w: HTTP response writer used to report parameter errors
+//   - r: HTTP request containing 'lat' and 'lon' query parameters
+//
+// Returns:
+//   - domain.Coordinates: Parsed coordinates
+//   - bool: true if parsing succeeded, false if an error response was written
+func (h *WeatherHandler) parseCoordinates(w http.ResponseWriter, r *http.Request) (domain.Coordinates, bool) {
 	latStr := r.URL.Query().Get("lat")
 	lonStr := r.URL.Query().Get("lon")
 
@@ -82,7 +801,7 @@ func (h *WeatherHandler) GetWeather(w http.ResponseWriter, r *http.Request) {
 			"Both 'lat' and 'lon' query parameters are required",
 		)
 
-		return
+		return domain.Coordinates{}, false
 	}
 
 	latitude, err := strconv.ParseFloat(latStr, 64)
@@ -95,7 +814,7 @@ func (h *WeatherHandler) GetWeather(w http.ResponseWriter, r *http.Request) {
 			"Invalid latitude format",
 		)
 
-		return
+		return domain.Coordinates{}, false
 	}
 
 	longitude, err := strconv.ParseFloat(lonStr, 64)
@@ -108,31 +827,13 @@ func (h *WeatherHandler) GetWeather(w http.ResponseWriter, r *http.Request) {
 			"Invalid longitude format",
 		)
 
-		return
+		return domain.Coordinates{}, false
 	}
 
-	coords := domain.Coordinates{
+	return domain.Coordinates{
 		Latitude:  latitude,
 		Longitude: longitude,
-	}
-
-	weather, err := h.service.GetWeather(r.Context(), coords)
-
-	if err != nil {
-		h.handleServiceError(w, r, err)
-		return
-	}
-
-	response := WeatherResponse{
-		Latitude:    weather.Coordinates.Latitude,
-		Longitude:   weather.Coordinates.Longitude,
-		Forecast:    weather.Forecast,
-		Temperature: weather.Temperature.Value,
-		TemperatureUnit: string(weather.Temperature.Unit),
-		Category:    string(weather.Category),
-	}
-
-	h.respondWithJSON(w, http.StatusOK, response)
+	}, true
 }
 
 // respondWithJSON sends a JSON response with the specified status code.
@@ -175,43 +876,57 @@ func (h *WeatherHandler) respondWithError(w http.ResponseWriter, status int, cod
 //
 // Error mappings:
 //   - WeatherError.INVALID_COORDINATES -> 400 Bad Request
+//   - WeatherError.INVALID_PROFILE -> 400 Bad Request
+//   - WeatherError.INVALID_LOCATION -> 400 Bad Request
+//   - WeatherError.LOCATION_NOT_FOUND -> 404 Not Found
+//   - WeatherError.LOCATION_NOT_SUPPORTED -> 422 Unprocessable Entity
+//   - WeatherError.GEOCODING_ERROR -> 503 Service Unavailable
 //   - WeatherError.FORECAST_RETRIEVAL_ERROR -> 503 Service Unavailable
+//   - WeatherError.OBSERVATION_RETRIEVAL_ERROR -> 503 Service Unavailable
+//   - WeatherError.ALERTS_RETRIEVAL_ERROR -> 503 Service Unavailable
 //   - Other errors -> 500 Internal Server Error
 func (h *WeatherHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error) {
 	var e *domain.WeatherError
 
-	switch {
-	case errors.As(err, &e):
-		switch e.Code {
-		case "INVALID_COORDINATES":
-			h.respondWithError(w, http.StatusBadRequest, e.Code, e.Message)
-		case "FORECAST_RETRIEVAL_ERROR":
-			h.respondWithError(
-				w,
-				http.StatusServiceUnavailable,
-				e.Code,
-				"Weather service is temporarily unavailable",
-			)
-		default:
-			h.respondWithError(
-				w,
-				http.StatusInternalServerError,
-				"INTERNAL_ERROR",
-				"An unexpected error occurred",
-			)
-		}
-	default:
+	if !errors.As(err, &e) {
 		h.logger.Error("unexpected error",
 			zap.Error(err),
 			zap.String("correlation_id", middleware.GetCorrelationID(r.Context())),
 			zap.String("request_id", middleware.GetRequestID(r.Context())),
 		)
+	}
 
-		h.respondWithError(
-			w,
-			http.StatusInternalServerError,
-			"INTERNAL_ERROR",
-			"An unexpected error occurred",
-		)
+	status, code, message := serviceErrorResponse(err)
+	h.respondWithError(w, status, code, message)
+}
+
+// serviceErrorResponse maps a service error to its HTTP status, error code and
+// client-facing message. Internal details are never exposed to clients.
+//
+// Parameters:
+//   - err: Error from the service layer
+//
+// Returns:
+//   - int: HTTP status code
+//   - string: Error code
+//   - string: Client-facing message
+func serviceErrorResponse(err error) (int, string, string) {
+	var e *domain.WeatherError
+
+	if errors.As(err, &e) {
+		switch e.Code {
+		case "INVALID_COORDINATES", "INVALID_PROFILE", "INVALID_LOCATION":
+			return http.StatusBadRequest, e.Code, e.Message
+		case "LOCATION_NOT_FOUND":
+			return http.StatusNotFound, e.Code, e.Message
+		case "LOCATION_NOT_SUPPORTED":
+			return http.StatusUnprocessableEntity, e.Code, e.Message
+		case "GEOCODING_ERROR":
+			return http.StatusServiceUnavailable, e.Code, "Location service is temporarily unavailable"
+		case "FORECAST_RETRIEVAL_ERROR", "OBSERVATION_RETRIEVAL_ERROR", "ALERTS_RETRIEVAL_ERROR":
+			return http.StatusServiceUnavailable, e.Code, "Weather service is temporarily unavailable"
+		}
 	}
+
+	return http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred"
 }
@@ -5,9 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -26,12 +29,13 @@ type MockWeatherService struct {
 // Parameters:
 //   - ctx: Context for the request
 //   - coords: Geographic coordinates
+//   - profile: Categorization profile name
 //
 // Returns:
 //   - *domain.Weather: Mocked weather data
 //   - error: Mocked error if configured
-func (m *MockWeatherService) GetWeather(ctx context.Context, coords domain.Coordinates) (*domain.Weather, error) {
-	args := m.Called(ctx, coords)
+func (m *MockWeatherService) GetWeather(ctx context.Context, coords domain.Coordinates, profile string) (*domain.Weather, error) {
+	args := m.Called(ctx, coords, profile)
 
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -40,6 +44,125 @@ func (m *MockWeatherService) GetWeather(ctx context.Context, coords domain.Coord
 	return args.Get(0).(*domain.Weather), args.Error(1)
 }
 
+// GetForecast mocks the weather service GetForecast method.
+//
+// Parameters:
+//   - ctx: Context for the request
+//   - coords: Geographic coordinates
+//   - profile: Categorization profile name
+//
+// Returns:
+//   - *domain.Forecast: Mocked forecast data
+//   - error: Mocked error if configured
+func (m *MockWeatherService) GetForecast(ctx context.Context, coords domain.Coordinates, profile string) (*domain.Forecast, error) {
+	args := m.Called(ctx, coords, profile)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Forecast), args.Error(1)
+}
+
+// GetHourlyForecast mocks the weather service GetHourlyForecast method.
+//
+// Parameters:
+//   - ctx: Context for the request
+//   - coords: Geographic coordinates
+//   - hours: Maximum number of hourly periods
+//   - profile: Categorization profile name
+//
+// Returns:
+//   - *domain.HourlyForecast: Mocked hourly forecast data
+//   - error: Mocked error if configured
+func (m *MockWeatherService) GetHourlyForecast(ctx context.Context, coords domain.Coordinates, hours int, profile string) (*domain.HourlyForecast, error) {
+	args := m.Called(ctx, coords, hours, profile)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.HourlyForecast), args.Error(1)
+}
+
+// GetObservation mocks the weather service GetObservation method.
+//
+// Parameters:
+//   - ctx: Context for the request
+//   - coords: Geographic coordinates
+//   - profile: Categorization profile name
+//
+// Returns:
+//   - *domain.Observation: Mocked observation data
+//   - error: Mocked error if configured
+func (m *MockWeatherService) GetObservation(ctx context.Context, coords domain.Coordinates, profile string) (*domain.Observation, error) {
+	args := m.Called(ctx, coords, profile)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Observation), args.Error(1)
+}
+
+// GetAlerts mocks the weather service GetAlerts method.
+//
+// Parameters:
+//   - ctx: Context for the request
+//   - coords: Geographic coordinates
+//
+// Returns:
+//   - *domain.AlertReport: Mocked active alerts
+//   - error: Mocked error if configured
+func (m *MockWeatherService) GetAlerts(ctx context.Context, coords domain.Coordinates) (*domain.AlertReport, error) {
+	args := m.Called(ctx, coords)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.AlertReport), args.Error(1)
+}
+
+// WarmLocation mocks the weather service WarmLocation method.
+//
+// Parameters:
+//   - ctx: Context for the request
+//   - coords: Geographic coordinates
+//   - horizon: How far ahead cached entries must stay fresh
+//
+// Returns:
+//   - int: Mocked number of refreshed entries
+//   - error: Mocked error if configured
+func (m *MockWeatherService) WarmLocation(ctx context.Context, coords domain.Coordinates, horizon time.Duration) (int, error) {
+	args := m.Called(ctx, coords, horizon)
+	return args.Int(0), args.Error(1)
+}
+
+// MockLocationService is a mock implementation of the LocationService interface.
+type MockLocationService struct {
+	mock.Mock
+}
+
+// ResolveLocation mocks the location service ResolveLocation method.
+//
+// Parameters:
+//   - ctx: Context for the request
+//   - query: Location query
+//
+// Returns:
+//   - *domain.Place: Mocked place
+//   - error: Mocked error
+func (m *MockLocationService) ResolveLocation(ctx context.Context, query domain.LocationQuery) (*domain.Place, error) {
+	args := m.Called(ctx, query)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Place), args.Error(1)
+}
+
 // TestWeatherHandler_GetWeather tests the GetWeather handler with various scenarios.
 func TestWeatherHandler_GetWeather(t *testing.T) {
 	logger := zap.NewNop()
@@ -69,6 +192,7 @@ func TestWeatherHandler_GetWeather(t *testing.T) {
 				Temperature: 75,
 				TemperatureUnit: "F",
 				Category:    "moderate",
+				Alerts:      []AlertSummaryResponse{},
 			},
 		},
 		{
@@ -133,6 +257,19 @@ func TestWeatherHandler_GetWeather(t *testing.T) {
 				Message: "Weather service is temporarily unavailable",
 			},
 		},
+		{
+			name:        "location not supported",
+			queryParams: "?lat=40.7128&lon=-74.0060",
+			mockError: &domain.WeatherError{
+				Code:    "LOCATION_NOT_SUPPORTED",
+				Message: "Weather data is not available for the requested location",
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody: ErrorResponse{
+				Error:   "LOCATION_NOT_SUPPORTED",
+				Message: "Weather data is not available for the requested location",
+			},
+		},
 		{
 			name:           "unexpected error",
 			queryParams:    "?lat=40.7128&lon=-74.0060",
@@ -148,20 +285,20 @@ func TestWeatherHandler_GetWeather(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := new(MockWeatherService)
-			handler := NewWeatherHandler(mockService, logger)
+			handler := NewWeatherHandler(mockService, nil, HandlerConfig{}, logger)
 
 			if tt.mockWeather != nil || tt.mockError != nil {
 				if tt.name == "invalid coordinates error" {
 					mockService.On("GetWeather", mock.Anything, mock.MatchedBy(func(coords domain.Coordinates) bool {
 						return coords.Latitude == 91
-					})).Return(tt.mockWeather, tt.mockError)
+					}), "").Return(tt.mockWeather, tt.mockError)
 				} else {
 					coords := domain.Coordinates{
 						Latitude:  40.7128,
 						Longitude: -74.0060,
 					}
 
-					mockService.On("GetWeather", mock.Anything, coords).
+					mockService.On("GetWeather", mock.Anything, coords, "").
 						Return(tt.mockWeather, tt.mockError)
 				}
 			}
@@ -194,3 +331,931 @@ func TestWeatherHandler_GetWeather(t *testing.T) {
 		})
 	}
 }
+
+// TestWeatherHandler_GetForecast tests the GetForecast handler with various scenarios.
+func TestWeatherHandler_GetForecast(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	start := time.Date(2024, 7, 1, 6, 0, 0, 0, time.UTC)
+
+	t.Run("successful request", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		handler := NewWeatherHandler(mockService, nil, HandlerConfig{}, logger)
+
+		mockService.On("GetForecast", mock.Anything, coords, "").Return(&domain.Forecast{
+			Coordinates: coords,
+			GeneratedAt: start.Add(-time.Hour),
+			UpdatedAt:   start.Add(-90 * time.Minute),
+			Periods: []domain.ForecastPeriod{
+				{
+					Name:             "Today",
+					StartTime:        start,
+					EndTime:          start.Add(12 * time.Hour),
+					IsDaytime:        true,
+					Temperature:      domain.Temperature{Value: 88, Unit: domain.Fahrenheit},
+					ShortForecast:    "Sunny",
+					DetailedForecast: "Sunny, with a high near 88.",
+					Category:         domain.Hot,
+				},
+				{
+					Name:          "Tonight",
+					StartTime:     start.Add(12 * time.Hour),
+					EndTime:       start.Add(24 * time.Hour),
+					Temperature:   domain.Temperature{Value: 68, Unit: domain.Fahrenheit},
+					ShortForecast: "Clear",
+					Category:      domain.Moderate,
+				},
+			},
+		}, nil)
+
+		req, _ := http.NewRequest("GET", "/forecast?lat=40.7128&lon=-74.0060", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetForecast(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp ForecastResponse
+
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Len(t, resp.Periods, 2)
+		assert.Equal(t, "Today", resp.Periods[0].Name)
+		assert.True(t, resp.Periods[0].IsDaytime)
+		assert.Equal(t, "hot", resp.Periods[0].Category)
+		assert.Equal(t, "Sunny, with a high near 88.", resp.Periods[0].DetailedForecast)
+		assert.True(t, start.Equal(resp.Periods[0].StartTime))
+		assert.Equal(t, "Tonight", resp.Periods[1].Name)
+		assert.Equal(t, "moderate", resp.Periods[1].Category)
+		assert.True(t, start.Add(-time.Hour).Equal(*resp.GeneratedAt))
+		assert.True(t, start.Add(-90*time.Minute).Equal(*resp.UpdatedAt))
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("missing parameters", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		handler := NewWeatherHandler(mockService, nil, HandlerConfig{}, logger)
+
+		req, _ := http.NewRequest("GET", "/forecast?lat=40.7128", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetForecast(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockService.AssertNotCalled(t, "GetForecast", mock.Anything, mock.Anything)
+	})
+
+	t.Run("service unavailable", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		handler := NewWeatherHandler(mockService, nil, HandlerConfig{}, logger)
+
+		mockService.On("GetForecast", mock.Anything, coords, "").Return(nil, &domain.WeatherError{
+			Code:    "FORECAST_RETRIEVAL_ERROR",
+			Message: "Failed to retrieve weather forecast",
+		})
+
+		req, _ := http.NewRequest("GET", "/forecast?lat=40.7128&lon=-74.0060", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetForecast(rr, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+// TestWeatherHandler_GetHourlyForecast tests the GetHourlyForecast handler with various scenarios.
+func TestWeatherHandler_GetHourlyForecast(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	start := time.Date(2024, 7, 1, 6, 0, 0, 0, time.UTC)
+
+	hourly := &domain.HourlyForecast{
+		Coordinates: coords,
+		Periods: []domain.ForecastPeriod{
+			{StartTime: start, EndTime: start.Add(time.Hour), Temperature: domain.Temperature{Value: 72, Unit: domain.Fahrenheit}, Category: domain.Moderate},
+			{StartTime: start.Add(time.Hour), EndTime: start.Add(2 * time.Hour), Temperature: domain.Temperature{Value: 74, Unit: domain.Fahrenheit}, Category: domain.Moderate},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		queryParams    string
+		expectedHours  int
+		expectedStatus int
+		expectedError  string
+	}{
+		{name: "default window", queryParams: "?lat=40.7128&lon=-74.0060", expectedHours: 0, expectedStatus: http.StatusOK},
+		{name: "capped window", queryParams: "?lat=40.7128&lon=-74.0060&hours=2", expectedHours: 2, expectedStatus: http.StatusOK},
+		{name: "non-numeric hours", queryParams: "?lat=40.7128&lon=-74.0060&hours=abc", expectedStatus: http.StatusBadRequest, expectedError: "INVALID_HOURS"},
+		{name: "zero hours", queryParams: "?lat=40.7128&lon=-74.0060&hours=0", expectedStatus: http.StatusBadRequest, expectedError: "INVALID_HOURS"},
+		{name: "too many hours", queryParams: "?lat=40.7128&lon=-74.0060&hours=157", expectedStatus: http.StatusBadRequest, expectedError: "INVALID_HOURS"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockWeatherService)
+			handler := NewWeatherHandler(mockService, nil, HandlerConfig{}, logger)
+
+			if tt.expectedStatus == http.StatusOK {
+				mockService.On("GetHourlyForecast", mock.Anything, coords, tt.expectedHours, "").Return(hourly, nil)
+			}
+
+			req, _ := http.NewRequest("GET", "/forecast/hourly"+tt.queryParams, nil)
+			rr := httptest.NewRecorder()
+
+			handler.GetHourlyForecast(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var resp HourlyForecastResponse
+
+				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+				assert.Len(t, resp.Periods, 2)
+				assert.Equal(t, float64(72), resp.Periods[0].Temperature)
+			} else {
+				var resp ErrorResponse
+
+				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+				assert.Equal(t, tt.expectedError, resp.Error)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+// TestWeatherHandler_GetObservation tests the GetObservation handler with various scenarios.
+func TestWeatherHandler_GetObservation(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	humidity := 65.0
+
+	t.Run("successful request omits unreported measurements", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		handler := NewWeatherHandler(mockService, nil, HandlerConfig{}, logger)
+
+		mockService.On("GetObservation", mock.Anything, coords, "").Return(&domain.Observation{
+			Coordinates:      coords,
+			StationID:        "KNYC",
+			StationName:      "New York City, Central Park",
+			StationDistance:  domain.Distance{Value: 8.2, Unit: domain.Kilometers},
+			Temperature:      domain.Temperature{Value: 22.8, Unit: domain.Celsius},
+			RelativeHumidity: &humidity,
+			WindSpeed:        &domain.Speed{Value: 14.8, Unit: domain.KilometersPerHour},
+		}, nil)
+
+		req, _ := http.NewRequest("GET", "/observations?lat=40.7128&lon=-74.0060", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetObservation(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var raw map[string]interface{}
+
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &raw))
+		assert.Equal(t, "KNYC", raw["stationId"])
+		assert.Equal(t, map[string]interface{}{"value": 14.8, "unit": "km/h"}, raw["windSpeed"])
+		assert.NotContains(t, raw, "pressure")
+		assert.NotContains(t, raw, "visibility")
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("no recent observations", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		handler := NewWeatherHandler(mockService, nil, HandlerConfig{}, logger)
+
+		mockService.On("GetObservation", mock.Anything, coords, "").Return(nil, &domain.WeatherError{
+			Code:    "OBSERVATION_RETRIEVAL_ERROR",
+			Message: "Failed to retrieve current observations",
+		})
+
+		req, _ := http.NewRequest("GET", "/observations?lat=40.7128&lon=-74.0060", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetObservation(rr, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+// TestWeatherHandler_GetAlerts tests the GetAlerts handler with various scenarios.
+func TestWeatherHandler_GetAlerts(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	expires := time.Date(2024, 7, 16, 20, 0, 0, 0, time.UTC)
+
+	t.Run("successful request", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		handler := NewWeatherHandler(mockService, nil, HandlerConfig{}, logger)
+
+		mockService.On("GetAlerts", mock.Anything, coords).Return(&domain.AlertReport{
+			Coordinates: coords,
+			Alerts: []domain.Alert{
+				{
+					ID:            "urn:oid:2.49.0.1.840.0.1",
+					Event:         "Heat Advisory",
+					Severity:      domain.SeverityModerate,
+					Urgency:       domain.UrgencyExpected,
+					Certainty:     domain.CertaintyLikely,
+					Headline:      "Heat Advisory issued for New York County",
+					AffectedZones: []string{"https://api.weather.gov/zones/forecast/NYZ072"},
+					Expires:       expires,
+				},
+			},
+		}, nil)
+
+		req, _ := http.NewRequest("GET", "/alerts?lat=40.7128&lon=-74.0060", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetAlerts(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp AlertsResponse
+
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Len(t, resp.Alerts, 1)
+		assert.Equal(t, "Heat Advisory", resp.Alerts[0].Event)
+		assert.Equal(t, "Moderate", resp.Alerts[0].Severity)
+		assert.Equal(t, expires, resp.Alerts[0].Expires)
+		assert.Nil(t, resp.Alerts[0].Onset)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("no active alerts returns empty list", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		handler := NewWeatherHandler(mockService, nil, HandlerConfig{}, logger)
+
+		mockService.On("GetAlerts", mock.Anything, coords).Return(&domain.AlertReport{Coordinates: coords}, nil)
+
+		req, _ := http.NewRequest("GET", "/alerts?lat=40.7128&lon=-74.0060", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetAlerts(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"alerts":[]`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("alerts unavailable", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		handler := NewWeatherHandler(mockService, nil, HandlerConfig{}, logger)
+
+		mockService.On("GetAlerts", mock.Anything, coords).Return(nil, &domain.WeatherError{
+			Code:    "ALERTS_RETRIEVAL_ERROR",
+			Message: "Failed to retrieve weather alerts",
+		})
+
+		req, _ := http.NewRequest("GET", "/alerts?lat=40.7128&lon=-74.0060", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetAlerts(rr, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+// TestWeatherHandler_Stale tests how data served from cache past its TTL is flagged.
+func TestWeatherHandler_Stale(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+
+	t.Run("fresh data carries no warning", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		handler := NewWeatherHandler(mockService, nil, HandlerConfig{}, logger)
+
+		mockService.On("GetForecast", mock.Anything, coords, "").Return(&domain.Forecast{
+			Coordinates: coords,
+			FetchedAt:   time.Now().Add(-time.Minute),
+		}, nil)
+
+		req, _ := http.NewRequest("GET", "/forecast?lat=40.7128&lon=-74.0060", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetForecast(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Empty(t, rr.Header().Get("Warning"))
+		assert.NotContains(t, rr.Body.String(), `"stale"`)
+		assert.NotContains(t, rr.Body.String(), `"dataAge"`)
+		assert.NotContains(t, rr.Body.String(), `"generatedAt"`, "unreported upstream times are omitted")
+	})
+
+	t.Run("stale data is flagged with its age", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		handler := NewWeatherHandler(mockService, nil, HandlerConfig{}, logger)
+
+		mockService.On("GetForecast", mock.Anything, coords, "").Return(&domain.Forecast{
+			Coordinates: coords,
+			FetchedAt:   time.Now().Add(-10 * time.Minute),
+			Stale:       true,
+		}, nil)
+
+		req, _ := http.NewRequest("GET", "/forecast?lat=40.7128&lon=-74.0060", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetForecast(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, `110 - "Response is Stale"`, rr.Header().Get("Warning"))
+
+		var resp ForecastResponse
+
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.True(t, resp.Stale)
+		assert.InDelta(t, 600, resp.DataAge, 5)
+	})
+
+	t.Run("batch is flagged when any result is stale", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		handler := NewWeatherHandler(mockService, nil, HandlerConfig{}, logger)
+		other := domain.Coordinates{Latitude: 34.0522, Longitude: -118.2437}
+
+		mockService.On("GetWeather", mock.Anything, coords, "").Return(&domain.Weather{
+			Coordinates: coords,
+			FetchedAt:   time.Now().Add(-time.Minute),
+		}, nil)
+		mockService.On("GetWeather", mock.Anything, other, "").Return(&domain.Weather{
+			Coordinates: other,
+			FetchedAt:   time.Now().Add(-2 * time.Hour),
+			Stale:       true,
+		}, nil)
+
+		body := `[{"lat": 40.7128, "lon": -74.0060}, {"lat": 34.0522, "lon": -118.2437}]`
+		req, _ := http.NewRequest("POST", "/weather/batch", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+
+		handler.GetWeatherBatch(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, `110 - "Response is Stale"`, rr.Header().Get("Warning"))
+
+		var resp BatchResponse
+
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.False(t, resp.Results[0].Weather.Stale)
+		assert.True(t, resp.Results[1].Weather.Stale)
+		assert.InDelta(t, 7200, resp.Results[1].Weather.DataAge, 5)
+	})
+}
+
+// TestWeatherHandler_Units tests server-side unit conversion via the 'units' query parameter.
+func TestWeatherHandler_Units(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+
+	weather := &domain.Weather{
+		Coordinates: coords,
+		Temperature: domain.Temperature{Value: 77, Unit: domain.Fahrenheit},
+		Forecast:    "Sunny",
+		Category:    domain.Moderate,
+	}
+
+	tests := []struct {
+		name                string
+		units               string
+		expectedStatus      int
+		expectedTemperature float64
+		expectedUnit        string
+	}{
+		{
+			name:                "provider units when omitted",
+			expectedStatus:      http.StatusOK,
+			expectedTemperature: 77,
+			expectedUnit:        "F",
+		},
+		{
+			name:                "metric",
+			units:               "metric",
+			expectedStatus:      http.StatusOK,
+			expectedTemperature: 25,
+			expectedUnit:        "C",
+		},
+		{
+			name:                "imperial",
+			units:               "imperial",
+			expectedStatus:      http.StatusOK,
+			expectedTemperature: 77,
+			expectedUnit:        "F",
+		},
+		{
+			name:                "si",
+			units:               "si",
+			expectedStatus:      http.StatusOK,
+			expectedTemperature: 298.15,
+			expectedUnit:        "K",
+		},
+		{
+			name:           "invalid units",
+			units:          "kelvin",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockWeatherService)
+			handler := NewWeatherHandler(mockService, nil, HandlerConfig{}, logger)
+
+			if tt.expectedStatus == http.StatusOK {
+				mockService.On("GetWeather", mock.Anything, coords, "").Return(weather, nil)
+			}
+
+			req, _ := http.NewRequest("GET", "/weather?lat=40.7128&lon=-74.0060&units="+tt.units, nil)
+			rr := httptest.NewRecorder()
+
+			handler.GetWeather(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedStatus != http.StatusOK {
+				var resp ErrorResponse
+
+				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+				assert.Equal(t, "INVALID_UNITS", resp.Error)
+
+				return
+			}
+
+			var resp WeatherResponse
+
+			assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+			assert.Equal(t, tt.expectedTemperature, resp.Temperature)
+			assert.Equal(t, tt.expectedUnit, resp.TemperatureUnit)
+			mockService.AssertExpectations(t)
+		})
+	}
+
+	t.Run("forecast wind follows the unit system", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		handler := NewWeatherHandler(mockService, nil, HandlerConfig{}, logger)
+		humidity := 68.0
+
+		mockService.On("GetWeather", mock.Anything, coords, "").Return(&domain.Weather{
+			Coordinates: coords,
+			Temperature: domain.Temperature{Value: 84, Unit: domain.Fahrenheit},
+			Wind: &domain.Wind{
+				MinSpeed:  domain.Speed{Value: 10, Unit: domain.MilesPerHour},
+				MaxSpeed:  domain.Speed{Value: 15, Unit: domain.MilesPerHour},
+				Direction: "SW",
+			},
+			RelativeHumidity: &humidity,
+			Dewpoint:         &domain.Temperature{Value: 20.5, Unit: domain.Celsius},
+			FeelsLike:        &domain.Temperature{Value: 87.5, Unit: domain.Fahrenheit},
+			Provider:         "openmeteo",
+		}, nil)
+
+		req, _ := http.NewRequest("GET", "/weather?lat=40.7128&lon=-74.0060&units=metric", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetWeather(rr, req)
+
+		var resp WeatherResponse
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Equal(t, &WindResponse{
+			MinSpeed:  MeasurementResponse{Value: 16.09, Unit: "km/h"},
+			MaxSpeed:  MeasurementResponse{Value: 24.14, Unit: "km/h"},
+			Direction: "SW",
+		}, resp.Wind)
+		assert.Equal(t, &humidity, resp.RelativeHumidity)
+		assert.Equal(t, &MeasurementResponse{Value: 20.5, Unit: "C"}, resp.Dewpoint)
+		assert.Equal(t, &MeasurementResponse{Value: 30.83, Unit: "C"}, resp.FeelsLike)
+		assert.Nil(t, resp.PrecipitationProbability)
+		assert.Equal(t, "openmeteo", resp.Provider)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("observation measurements follow the unit system", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		handler := NewWeatherHandler(mockService, nil, HandlerConfig{}, logger)
+
+		mockService.On("GetObservation", mock.Anything, coords, "").Return(&domain.Observation{
+			Coordinates:     coords,
+			StationID:       "KNYC",
+			StationDistance: domain.Distance{Value: 8.2, Unit: domain.Kilometers},
+			Temperature:     domain.Temperature{Value: 20, Unit: domain.Celsius},
+			WindSpeed:       &domain.Speed{Value: 16.0934, Unit: domain.KilometersPerHour},
+			Pressure:        &domain.Pressure{Value: 101590, Unit: domain.Pascal},
+			Visibility:      &domain.Distance{Value: 16093.44, Unit: domain.Meters},
+		}, nil)
+
+		req, _ := http.NewRequest("GET", "/observations?lat=40.7128&lon=-74.0060&units=imperial", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetObservation(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp ObservationResponse
+
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Equal(t, 68.0, resp.Temperature)
+		assert.Equal(t, "F", resp.TemperatureUnit)
+		assert.Equal(t, &MeasurementResponse{Value: 10, Unit: "mph"}, resp.WindSpeed)
+		assert.Equal(t, &MeasurementResponse{Value: 30, Unit: "inHg"}, resp.Pressure)
+		assert.Equal(t, &MeasurementResponse{Value: 10, Unit: "mi"}, resp.Visibility)
+		assert.Equal(t, MeasurementResponse{Value: 5.1, Unit: "mi"}, resp.StationDistance)
+		mockService.AssertExpectations(t)
+	})
+}
+
+// TestWeatherHandler_Profile tests categorization profile selection.
+func TestWeatherHandler_Profile(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	apiKeyProfiles := map[string]string{"tropics-team-key": "tropical"}
+
+	tests := []struct {
+		name            string
+		query           string
+		apiKey          string
+		expectedProfile string
+	}{
+		{
+			name:            "service default when nothing selected",
+			expectedProfile: "",
+		},
+		{
+			name:            "query parameter",
+			query:           "&profile=detailed",
+			expectedProfile: "detailed",
+		},
+		{
+			name:            "API key default",
+			apiKey:          "tropics-team-key",
+			expectedProfile: "tropical",
+		},
+		{
+			name:            "query parameter overrides API key default",
+			query:           "&profile=detailed",
+			apiKey:          "tropics-team-key",
+			expectedProfile: "detailed",
+		},
+		{
+			name:            "unknown API key",
+			apiKey:          "other-key",
+			expectedProfile: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockWeatherService)
+			handler := NewWeatherHandler(mockService, nil, HandlerConfig{APIKeyProfiles: apiKeyProfiles}, logger)
+
+			mockService.On("GetWeather", mock.Anything, coords, tt.expectedProfile).Return(&domain.Weather{
+				Coordinates: coords,
+				Temperature: domain.Temperature{Value: 75, Unit: domain.Fahrenheit},
+				Category:    domain.Mild,
+				Profile:     "echoed",
+			}, nil)
+
+			req, _ := http.NewRequest("GET", "/weather?lat=40.7128&lon=-74.0060"+tt.query, nil)
+
+			if tt.apiKey != "" {
+				req.Header.Set("X-API-Key", tt.apiKey)
+			}
+
+			rr := httptest.NewRecorder()
+
+			handler.GetWeather(rr, req)
+
+			var resp WeatherResponse
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+			assert.Equal(t, "echoed", resp.Profile)
+			assert.Equal(t, "mild", resp.Category)
+			mockService.AssertExpectations(t)
+		})
+	}
+
+	t.Run("unknown profile", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		handler := NewWeatherHandler(mockService, nil, HandlerConfig{}, logger)
+
+		mockService.On("GetForecast", mock.Anything, coords, "tropical").Return(nil, &domain.WeatherError{
+			Code:    "INVALID_PROFILE",
+			Message: "Unknown categorization profile 'tropical'",
+		})
+
+		req, _ := http.NewRequest("GET", "/forecast?lat=40.7128&lon=-74.0060&profile=tropical", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetForecast(rr, req)
+
+		var resp ErrorResponse
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Equal(t, ErrorResponse{Error: "INVALID_PROFILE", Message: "Unknown categorization profile 'tropical'"}, resp)
+		mockService.AssertExpectations(t)
+	})
+}
+
+// TestWeatherHandler_GetWeatherBatch tests the batch weather endpoint.
+func TestWeatherHandler_GetWeatherBatch(t *testing.T) {
+	logger := zap.NewNop()
+	nyc := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	invalid := domain.Coordinates{Latitude: 91, Longitude: 0}
+	denver := domain.Coordinates{Latitude: 39.7392, Longitude: -104.9903}
+
+	t.Run("reports per-item results and errors", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		handler := NewWeatherHandler(mockService, nil, HandlerConfig{BatchConcurrency: 2}, logger)
+
+		mockService.On("GetWeather", mock.Anything, nyc, "").Return(&domain.Weather{
+			Coordinates: nyc,
+			Temperature: domain.Temperature{Value: 75, Unit: domain.Fahrenheit},
+			Forecast:    "Sunny",
+			Category:    domain.Moderate,
+		}, nil)
+		mockService.On("GetWeather", mock.Anything, invalid, "").Return(nil, &domain.WeatherError{
+			Code:    "INVALID_COORDINATES",
+			Message: "The provided coordinates are invalid",
+		})
+		mockService.On("GetWeather", mock.Anything, denver, "").Return(nil, &domain.WeatherError{
+			Code:    "FORECAST_RETRIEVAL_ERROR",
+			Message: "Failed to retrieve weather forecast",
+		})
+
+		body := `[{"lat":40.7128,"lon":-74.0060},{"lat":91,"lon":0},{"lat":39.7392,"lon":-104.9903},{"lat":10}]`
+		req, _ := http.NewRequest("POST", "/weather/batch?units=metric", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+
+		handler.GetWeatherBatch(rr, req)
+
+		var resp BatchResponse
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Equal(t, 1, resp.Succeeded)
+		assert.Equal(t, 3, resp.Failed)
+		assert.Len(t, resp.Results, 4)
+
+		assert.Equal(t, 0, resp.Results[0].Index)
+		assert.Nil(t, resp.Results[0].Error)
+		assert.Equal(t, 23.89, resp.Results[0].Weather.Temperature)
+		assert.Equal(t, "C", resp.Results[0].Weather.TemperatureUnit)
+
+		assert.Nil(t, resp.Results[1].Weather)
+		assert.Equal(t, "INVALID_COORDINATES", resp.Results[1].Error.Error)
+		assert.Equal(t, "FORECAST_RETRIEVAL_ERROR", resp.Results[2].Error.Error)
+		assert.Equal(t, "Weather service is temporarily unavailable", resp.Results[2].Error.Message)
+		assert.Equal(t, 3, resp.Results[3].Index)
+		assert.Equal(t, "MISSING_PARAMETERS", resp.Results[3].Error.Error)
+		mockService.AssertNumberOfCalls(t, "GetWeather", 3)
+	})
+
+	t.Run("stops dispatching once the client disconnects", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		handler := NewWeatherHandler(mockService, nil, HandlerConfig{BatchConcurrency: 2}, logger)
+		ctx, cancel := context.WithCancel(context.Background())
+
+		// The first lookup sees the client disconnect; every lookup runs until cancelled
+		mockService.On("GetWeather", mock.Anything, nyc, "").
+			Run(func(args mock.Arguments) {
+				cancel()
+				<-args.Get(0).(context.Context).Done()
+			}).
+			Return(nil, context.Canceled)
+
+		body := "[" + strings.TrimSuffix(strings.Repeat(`{"lat":40.7128,"lon":-74.0060},`, 20), ",") + "]"
+		req, _ := http.NewRequestWithContext(ctx, "POST", "/weather/batch", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+
+		handler.GetWeatherBatch(rr, req)
+
+		var resp BatchResponse
+
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Equal(t, 20, resp.Failed)
+		assert.Equal(t, "REQUEST_CANCELLED", resp.Results[19].Error.Error)
+		assert.Equal(t, 40.7128, resp.Results[19].Latitude)
+		assert.LessOrEqual(t, len(mockService.Calls), 2, "no more lookups start than the concurrency cap")
+	})
+
+	tests := []struct {
+		name          string
+		body          string
+		expectedError string
+	}{
+		{
+			name:          "malformed body",
+			body:          `{"lat":40.7128,"lon":-74.0060}`,
+			expectedError: "INVALID_BATCH",
+		},
+		{
+			name:          "empty batch",
+			body:          `[]`,
+			expectedError: "INVALID_BATCH",
+		},
+		{
+			name:          "too many locations",
+			body:          `[{"lat":1,"lon":1},{"lat":2,"lon":2},{"lat":3,"lon":3}]`,
+			expectedError: "BATCH_TOO_LARGE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockWeatherService)
+			handler := NewWeatherHandler(mockService, nil, HandlerConfig{BatchMaxItems: 2}, logger)
+
+			req, _ := http.NewRequest("POST", "/weather/batch", strings.NewReader(tt.body))
+			rr := httptest.NewRecorder()
+
+			handler.GetWeatherBatch(rr, req)
+
+			var resp ErrorResponse
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+			assert.Equal(t, tt.expectedError, resp.Error)
+			mockService.AssertNotCalled(t, "GetWeather", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestWeatherHandler_BatchCost tests the weighted rate-limit cost of batch requests.
+func TestWeatherHandler_BatchCost(t *testing.T) {
+	locations := func(n int) string {
+		items := make([]string, n)
+
+		for i := range items {
+			items[i] = `{"lat":40.7128,"lon":-74.0060}`
+		}
+
+		return "[" + strings.Join(items, ",") + "]"
+	}
+
+	tests := []struct {
+		name     string
+		itemCost float64
+		body     string
+		expected int
+	}{
+		{name: "default cost", body: locations(15), expected: 3},
+		{name: "rounds up", body: locations(6), expected: 2},
+		{name: "full cost", itemCost: 1, body: locations(15), expected: 15},
+		{name: "minimum of one", body: locations(1), expected: 1},
+		{name: "malformed body", body: `not json`, expected: 1},
+		{name: "oversized batch", body: locations(501), expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewWeatherHandler(new(MockWeatherService), nil, HandlerConfig{BatchItemCost: tt.itemCost}, zap.NewNop())
+			req, _ := http.NewRequest("POST", "/weather/batch", strings.NewReader(tt.body))
+
+			assert.Equal(t, tt.expected, handler.BatchCost(req))
+
+			// The body must remain readable by the handler
+			remaining, err := io.ReadAll(req.Body)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.body, string(remaining))
+		})
+	}
+}
+
+// TestWeatherHandler_LocationQuery tests resolving 'q', 'zip' and 'city'/'state' to coordinates.
+func TestWeatherHandler_LocationQuery(t *testing.T) {
+	logger := zap.NewNop()
+	denver := &domain.Place{
+		Name:        "Denver",
+		State:       "CO",
+		Coordinates: domain.Coordinates{Latitude: 39.7392, Longitude: -104.9903},
+		Source:      "gazetteer",
+	}
+
+	t.Run("weather by city and state", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		mockLocations := new(MockLocationService)
+		handler := NewWeatherHandler(mockService, mockLocations, HandlerConfig{}, logger)
+
+		mockLocations.On("ResolveLocation", mock.Anything, domain.LocationQuery{City: "Denver", State: "CO"}).Return(denver, nil)
+		mockService.On("GetWeather", mock.Anything, denver.Coordinates, "").Return(&domain.Weather{
+			Coordinates: denver.Coordinates,
+			Temperature: domain.Temperature{Value: 60, Unit: domain.Fahrenheit},
+			Forecast:    "Sunny",
+			Category:    domain.Moderate,
+		}, nil)
+
+		req, _ := http.NewRequest("GET", "/weather?city=Denver&state=CO", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetWeather(rr, req)
+
+		var resp WeatherResponse
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Equal(t, 39.7392, resp.Latitude)
+		assert.Equal(t, &LocationResponse{
+			Name:      "Denver",
+			State:     "CO",
+			Latitude:  39.7392,
+			Longitude: -104.9903,
+			Source:    "gazetteer",
+		}, resp.Location)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("forecast by free text", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		mockLocations := new(MockLocationService)
+		handler := NewWeatherHandler(mockService, mockLocations, HandlerConfig{}, logger)
+
+		mockLocations.On("ResolveLocation", mock.Anything, domain.LocationQuery{Text: "Denver, CO"}).Return(denver, nil)
+		mockService.On("GetForecast", mock.Anything, denver.Coordinates, "").Return(&domain.Forecast{
+			Coordinates: denver.Coordinates,
+		}, nil)
+
+		req, _ := http.NewRequest("GET", "/forecast?q=Denver,+CO", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetForecast(rr, req)
+
+		var resp ForecastResponse
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Equal(t, "Denver", resp.Location.Name)
+	})
+
+	t.Run("coordinates omit location", func(t *testing.T) {
+		mockService := new(MockWeatherService)
+		handler := NewWeatherHandler(mockService, new(MockLocationService), HandlerConfig{}, logger)
+
+		mockService.On("GetAlerts", mock.Anything, denver.Coordinates).Return(&domain.AlertReport{
+			Coordinates: denver.Coordinates,
+		}, nil)
+
+		req, _ := http.NewRequest("GET", "/alerts?lat=39.7392&lon=-104.9903", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetAlerts(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.NotContains(t, rr.Body.String(), `"location"`)
+	})
+
+	tests := []struct {
+		name           string
+		query          string
+		resolveErr     error
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name:           "unknown place",
+			query:          "?zip=00000",
+			resolveErr:     &domain.WeatherError{Code: "LOCATION_NOT_FOUND", Message: "No location matches the query"},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "LOCATION_NOT_FOUND",
+		},
+		{
+			name:           "geocoder unavailable",
+			query:          "?zip=80202",
+			resolveErr:     &domain.WeatherError{Code: "GEOCODING_ERROR", Message: "Failed to resolve location"},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedError:  "GEOCODING_ERROR",
+		},
+		{
+			name:           "coordinates and query together",
+			query:          "?zip=80202&lat=39.7&lon=-104.9",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_LOCATION",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockWeatherService)
+			mockLocations := new(MockLocationService)
+			handler := NewWeatherHandler(mockService, mockLocations, HandlerConfig{}, logger)
+
+			mockLocations.On("ResolveLocation", mock.Anything, mock.Anything).Return(nil, tt.resolveErr)
+
+			req, _ := http.NewRequest("GET", "/observations"+tt.query, nil)
+			rr := httptest.NewRecorder()
+
+			handler.GetObservation(rr, req)
+
+			var resp ErrorResponse
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+			assert.Equal(t, tt.expectedError, resp.Error)
+			mockService.AssertNotCalled(t, "GetObservation", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Package coverage describes the geographic areas each weather provider serves.
+// Areas are polygons or bounding boxes in an embedded JSON dataset, so requests
+// can be routed to a provider that covers the point without calling any that do not.
+package coverage
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+)
+
+// embeddedCoverage is the built-in coverage dataset, keyed by provider name.
+// Boundaries are approximate and err on the side of including coastal waters;
+// providers still report points they cannot serve inside these areas.
+//
+//go:embed coverage.json
+var embeddedCoverage []byte
+
+// Area is a named region, bounded by a box and optionally refined by a polygon.
+type Area struct {
+	// Name describes the region (e.g. "Hawaii")
+	Name string
+
+	// MinLatitude, MaxLatitude, MinLongitude and MaxLongitude bound the region
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+
+	// Polygon is the region outline; nil when the bounding box is the region
+	Polygon []domain.Coordinates
+}
+
+// Contains reports whether the point lies within the area. Points on the
+// bounding box edges are inside; polygon edges follow the even-odd rule.
+func (a Area) Contains(coords domain.Coordinates) bool {
+	if coords.Latitude < a.MinLatitude || coords.Latitude > a.MaxLatitude ||
+		coords.Longitude < a.MinLongitude || coords.Longitude > a.MaxLongitude {
+		return false
+	}
+
+	if a.Polygon == nil {
+		return true
+	}
+
+	inside := false
+
+	for i, j := 0, len(a.Polygon)-1; i < len(a.Polygon); j, i = i, i+1 {
+		pi, pj := a.Polygon[i], a.Polygon[j]
+
+		if (pi.Latitude > coords.Latitude) == (pj.Latitude > coords.Latitude) {
+			continue
+		}
+
+		crossing := pi.Longitude + (coords.Latitude-pi.Latitude)*(pj.Longitude-pi.Longitude)/(pj.Latitude-pi.Latitude)
+
+		if coords.Longitude < crossing {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+// Coverage is the set of areas served by one provider.
+type Coverage []Area
+
+// Contains reports whether any area covers the point.
+func (c Coverage) Contains(coords domain.Coordinates) bool {
+	for _, area := range c {
+		if area.Contains(coords) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Map holds the coverage of each provider, keyed by provider name.
+type Map map[string]Coverage
+
+// areaJSON is the dataset representation of an area. Coordinates are
+// [longitude, latitude] pairs and bbox is [west, south, east, north], as in GeoJSON.
+type areaJSON struct {
+	Name    string       `json:"name"`
+	BBox    []float64    `json:"bbox"`
+	Polygon [][2]float64 `json:"polygon"`
+}
+
+// Default returns the embedded coverage of the built-in providers.
+//
+// Returns:
+//   - Map: Coverage keyed by provider name
+//   - error: Parse error if the embedded dataset is malformed
+func Default() (Map, error) {
+	return Load(bytes.NewReader(embeddedCoverage))
+}
+
+// Load reads coverage from JSON mapping provider names to lists of areas.
+// Each area has a name and either a "bbox" or a closed "polygon".
+//
+// Parameters:
+//   - r: JSON data
+//
+// Returns:
+//   - Map: Coverage keyed by provider name
+//   - error: Decode error or an area that is neither a valid box nor polygon
+func Load(r io.Reader) (Map, error) {
+	var raw map[string][]areaJSON
+
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("failed to decode coverage: %w", err)
+	}
+
+	result := make(Map, len(raw))
+
+	for provider, areas := range raw {
+		for _, a := range areas {
+			area, err := a.toArea()
+
+			if err != nil {
+				return nil, fmt.Errorf("coverage for %s: area %q: %w", provider, a.Name, err)
+			}
+
+			result[provider] = append(result[provider], area)
+		}
+	}
+
+	return result, nil
+}
+
+// toArea validates a dataset area and computes its bounding box.
+func (a areaJSON) toArea() (Area, error) {
+	switch {
+	case a.BBox != nil && a.Polygon != nil:
+		return Area{}, fmt.Errorf("has both bbox and polygon")
+	case a.BBox != nil:
+		if len(a.BBox) != 4 || a.BBox[0] > a.BBox[2] || a.BBox[1] > a.BBox[3] {
+			return Area{}, fmt.Errorf("bbox must be [west, south, east, north]")
+		}
+
+		return Area{
+			Name:         a.Name,
+			MinLongitude: a.BBox[0],
+			MinLatitude:  a.BBox[1],
+			MaxLongitude: a.BBox[2],
+			MaxLatitude:  a.BBox[3],
+		}, nil
+	case len(a.Polygon) >= 4:
+		if a.Polygon[0] != a.Polygon[len(a.Polygon)-1] {
+			return Area{}, fmt.Errorf("polygon must be closed")
+		}
+
+		area := Area{
+			Name:         a.Name,
+			MinLongitude: a.Polygon[0][0],
+			MaxLongitude: a.Polygon[0][0],
+			MinLatitude:  a.Polygon[0][1],
+			MaxLatitude:  a.Polygon[0][1],
+			Polygon:      make([]domain.Coordinates, 0, len(a.Polygon)),
+		}
+
+		for _, p := range a.Polygon {
+			area.MinLongitude = min(area.MinLongitude, p[0])
+			area.MaxLongitude = max(area.MaxLongitude, p[0])
+			area.MinLatitude = min(area.MinLatitude, p[1])
+			area.MaxLatitude = max(area.MaxLatitude, p[1])
+			area.Polygon = append(area.Polygon, domain.Coordinates{Latitude: p[1], Longitude: p[0]})
+		}
+
+		return area, nil
+	default:
+		return Area{}, fmt.Errorf("needs a bbox or a polygon with at least four points")
+	}
+}
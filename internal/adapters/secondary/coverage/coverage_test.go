@@ -0,0 +1,100 @@
+// Package coverage contains unit tests for provider coverage areas.
+package coverage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+)
+
+// TestDefault tests the embedded coverage of the built-in providers.
+func TestDefault(t *testing.T) {
+	coverage, err := Default()
+
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		coords    domain.Coordinates
+		nws       bool
+		openmeteo bool
+	}{
+		{name: "New York", coords: domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}, nws: true, openmeteo: true},
+		{name: "Denver", coords: domain.Coordinates{Latitude: 39.7392, Longitude: -104.9903}, nws: true, openmeteo: true},
+		{name: "Key West", coords: domain.Coordinates{Latitude: 24.5551, Longitude: -81.7800}, nws: true, openmeteo: true},
+		{name: "Seattle", coords: domain.Coordinates{Latitude: 47.6062, Longitude: -122.3321}, nws: true, openmeteo: true},
+		{name: "Buffalo", coords: domain.Coordinates{Latitude: 42.8864, Longitude: -78.8784}, nws: true, openmeteo: true},
+		{name: "Anchorage", coords: domain.Coordinates{Latitude: 61.2181, Longitude: -149.9003}, nws: true, openmeteo: true},
+		{name: "Attu Island", coords: domain.Coordinates{Latitude: 52.9, Longitude: 173.1}, nws: true, openmeteo: true},
+		{name: "Honolulu", coords: domain.Coordinates{Latitude: 21.3069, Longitude: -157.8583}, nws: true, openmeteo: true},
+		{name: "San Juan", coords: domain.Coordinates{Latitude: 18.4655, Longitude: -66.1057}, nws: true, openmeteo: true},
+		{name: "Guam", coords: domain.Coordinates{Latitude: 13.4443, Longitude: 144.7937}, nws: true, openmeteo: true},
+		{name: "Pago Pago", coords: domain.Coordinates{Latitude: -14.2756, Longitude: -170.7020}, nws: true, openmeteo: true},
+		{name: "Paris", coords: domain.Coordinates{Latitude: 48.8566, Longitude: 2.3522}, openmeteo: true},
+		{name: "Toronto", coords: domain.Coordinates{Latitude: 43.6532, Longitude: -79.3832}, openmeteo: true},
+		{name: "Vancouver", coords: domain.Coordinates{Latitude: 49.2827, Longitude: -123.1207}, openmeteo: true},
+		{name: "Mexico City", coords: domain.Coordinates{Latitude: 19.4326, Longitude: -99.1332}, openmeteo: true},
+		{name: "Gulf of Mexico", coords: domain.Coordinates{Latitude: 25.0, Longitude: -90.0}, openmeteo: true},
+		{name: "South Pole", coords: domain.Coordinates{Latitude: -90, Longitude: 0}, openmeteo: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.nws, coverage["nws"].Contains(tt.coords), "nws")
+			assert.Equal(t, tt.openmeteo, coverage["openmeteo"].Contains(tt.coords), "openmeteo")
+		})
+	}
+}
+
+// TestLoad tests validation of coverage datasets.
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          string
+		expectedError string
+	}{
+		{
+			name: "bbox and polygon",
+			data: `{"a":[{"name":"box","bbox":[0,0,1,1]},{"name":"triangle","polygon":[[0,0],[1,0],[0,1],[0,0]]}]}`,
+		},
+		{
+			name:          "inverted bbox",
+			data:          `{"a":[{"name":"box","bbox":[1,0,0,1]}]}`,
+			expectedError: "bbox must be",
+		},
+		{
+			name:          "open polygon",
+			data:          `{"a":[{"name":"open","polygon":[[0,0],[1,0],[0,1],[1,1]]}]}`,
+			expectedError: "must be closed",
+		},
+		{
+			name:          "no geometry",
+			data:          `{"a":[{"name":"empty"}]}`,
+			expectedError: "needs a bbox or a polygon",
+		},
+		{
+			name:          "not JSON",
+			data:          `nws: everywhere`,
+			expectedError: "failed to decode coverage",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coverage, err := Load(strings.NewReader(tt.data))
+
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, coverage["a"], 2)
+			assert.True(t, coverage["a"].Contains(domain.Coordinates{Latitude: 0.2, Longitude: 0.2}))
+			assert.False(t, coverage["a"][1].Contains(domain.Coordinates{Latitude: 0.9, Longitude: 0.9}))
+		})
+	}
+}
@@ -0,0 +1,186 @@
+// Package failover implements a WeatherClient that tries several weather
+// providers in priority order, moving on to the next provider whenever one
+// fails or its circuit breaker is open. Providers are only called for
+// locations inside their coverage.
+package failover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// Provider is a named weather client taking part in failover.
+type Provider struct {
+	// Name identifies the provider in logs and errors (e.g. "nws")
+	Name string
+
+	// Client retrieves data from the provider, typically behind a circuit breaker
+	Client ports.WeatherClient
+
+	// Coverage limits the provider to the locations it serves; nil covers everywhere
+	Coverage Coverage
+}
+
+// Coverage reports whether a provider serves a location.
+type Coverage interface {
+	// Contains reports whether the coordinates are inside the covered area
+	Contains(coords domain.Coordinates) bool
+}
+
+// Client implements the WeatherClient interface over an ordered list of providers.
+// The first covering provider to succeed serves the request; its data names it in Provider.
+type Client struct {
+	// providers are tried in priority order
+	providers []Provider
+
+	// logger records failovers
+	logger *zap.Logger
+}
+
+// NewClient creates a weather client that fails over between providers.
+//
+// Parameters:
+//   - logger: Zap logger for recording failovers
+//   - providers: Providers in priority order, highest priority first
+//
+// Returns:
+//   - *Client: Failover weather client
+func NewClient(logger *zap.Logger, providers ...Provider) *Client {
+	return &Client{
+		providers: providers,
+		logger:    logger,
+	}
+}
+
+// GetForecast retrieves current forecast data from the first provider that succeeds.
+func (c *Client) GetForecast(ctx context.Context, coords domain.Coordinates) (*ports.WeatherData, error) {
+	return try(ctx, c, coords, "get-forecast", func(client ports.WeatherClient) (*ports.WeatherData, error) {
+		return client.GetForecast(ctx, coords)
+	})
+}
+
+// GetForecastPeriods retrieves multi-day forecast periods from the first provider that succeeds.
+func (c *Client) GetForecastPeriods(ctx context.Context, coords domain.Coordinates) (*ports.ForecastData, error) {
+	return try(ctx, c, coords, "get-forecast-periods", func(client ports.WeatherClient) (*ports.ForecastData, error) {
+		return client.GetForecastPeriods(ctx, coords)
+	})
+}
+
+// GetHourlyForecast retrieves hourly forecast periods from the first provider that succeeds.
+func (c *Client) GetHourlyForecast(ctx context.Context, coords domain.Coordinates) (*ports.ForecastData, error) {
+	return try(ctx, c, coords, "get-hourly-forecast", func(client ports.WeatherClient) (*ports.ForecastData, error) {
+		return client.GetHourlyForecast(ctx, coords)
+	})
+}
+
+// GetObservation retrieves the latest observation from the first provider that succeeds.
+func (c *Client) GetObservation(ctx context.Context, coords domain.Coordinates) (*ports.ObservationData, error) {
+	return try(ctx, c, coords, "get-observation", func(client ports.WeatherClient) (*ports.ObservationData, error) {
+		return client.GetObservation(ctx, coords)
+	})
+}
+
+// GetAlerts retrieves active alerts from the first provider that succeeds.
+// Providers that do not publish alerts report the location as unsupported and are skipped.
+func (c *Client) GetAlerts(ctx context.Context, coords domain.Coordinates) ([]ports.AlertData, error) {
+	return try(ctx, c, coords, "get-alerts", func(client ports.WeatherClient) ([]ports.AlertData, error) {
+		return client.GetAlerts(ctx, coords)
+	})
+}
+
+// LocationKey resolves the location key of the first provider that succeeds, which is
+// the provider expected to serve forecasts for the coordinates.
+func (c *Client) LocationKey(ctx context.Context, coords domain.Coordinates) (string, error) {
+	return try(ctx, c, coords, "location-key", func(client ports.WeatherClient) (string, error) {
+		return client.LocationKey(ctx, coords)
+	})
+}
+
+// try calls each provider that covers the coordinates, in order, until one succeeds.
+// Providers that report the location as unsupported are skipped like those whose
+// coverage excludes it. A *ports.NotModifiedError ends the search, since the
+// provider confirmed the caller's cached copy.
+//
+// Parameters:
+//   - ctx: Context; once cancelled, no further providers are tried
+//   - c: Failover client holding the providers
+//   - coords: Requested location, used for coverage routing
+//   - operation: Operation name for logging
+//   - call: Invokes the operation on one provider
+//
+// Returns:
+//   - T: Result from the first provider that succeeded
+//   - error: Every provider failure, joined, if any provider failed; otherwise
+//     an error wrapping domain.ErrLocationNotSupported
+func try[T any](ctx context.Context, c *Client, coords domain.Coordinates, operation string, call func(ports.WeatherClient) (T, error)) (T, error) {
+	var (
+		zero     T
+		failures []error
+		attempts int
+	)
+
+	for _, provider := range c.providers {
+		if provider.Coverage != nil && !provider.Coverage.Contains(coords) {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			failures = append(failures, err)
+			break
+		}
+
+		result, err := call(provider.Client)
+		attempts++
+
+		if err == nil {
+			if attempts > 1 {
+				c.logger.Info("weather request served by fallback provider",
+					zap.String("operation", operation),
+					zap.String("provider", provider.Name),
+				)
+			}
+
+			return result, nil
+		}
+
+		// A 304 confirms the caller's cached copy, so there is nothing to fail over from
+		if errors.As(err, new(*ports.NotModifiedError)) {
+			return zero, err
+		}
+
+		if errors.Is(err, domain.ErrLocationNotSupported) {
+			c.logger.Debug("weather provider does not support location",
+				zap.String("operation", operation),
+				zap.String("provider", provider.Name),
+				zap.Error(err),
+			)
+
+			continue
+		}
+
+		c.logger.Warn("weather provider failed",
+			zap.String("operation", operation),
+			zap.String("provider", provider.Name),
+			zap.Error(err),
+		)
+
+		failures = append(failures, fmt.Errorf("%s: %w", provider.Name, err))
+	}
+
+	if len(c.providers) == 0 {
+		return zero, fmt.Errorf("no weather providers configured")
+	}
+
+	if len(failures) > 0 {
+		return zero, fmt.Errorf("all weather providers failed: %w", errors.Join(failures...))
+	}
+
+	return zero, fmt.Errorf("%w: no weather provider serves %s for %.4f,%.4f",
+		domain.ErrLocationNotSupported, operation, coords.Latitude, coords.Longitude)
+}
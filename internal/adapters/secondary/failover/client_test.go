@@ -0,0 +1,264 @@
+// Package failover contains unit tests for the failover weather client.
+package failover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sony/gobreaker"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// stubClient returns fixed forecast data or an error from every method.
+type stubClient struct {
+	provider string
+	err      error
+	calls    int
+}
+
+// GetForecast returns the stub's data or error.
+func (s *stubClient) GetForecast(context.Context, domain.Coordinates) (*ports.WeatherData, error) {
+	s.calls++
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return &ports.WeatherData{Temperature: 20, Unit: domain.Celsius, Provider: s.provider}, nil
+}
+
+// GetForecastPeriods returns the stub's data or error.
+func (s *stubClient) GetForecastPeriods(context.Context, domain.Coordinates) (*ports.ForecastData, error) {
+	s.calls++
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return &ports.ForecastData{Provider: s.provider}, nil
+}
+
+// GetHourlyForecast returns the stub's data or error.
+func (s *stubClient) GetHourlyForecast(context.Context, domain.Coordinates) (*ports.ForecastData, error) {
+	s.calls++
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return &ports.ForecastData{Provider: s.provider}, nil
+}
+
+// GetObservation returns the stub's data or error.
+func (s *stubClient) GetObservation(context.Context, domain.Coordinates) (*ports.ObservationData, error) {
+	s.calls++
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return &ports.ObservationData{Provider: s.provider}, nil
+}
+
+// GetAlerts returns no alerts or the stub's error.
+func (s *stubClient) GetAlerts(context.Context, domain.Coordinates) ([]ports.AlertData, error) {
+	s.calls++
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return []ports.AlertData{}, nil
+}
+
+// LocationKey returns a key naming the stub's provider or the stub's error.
+func (s *stubClient) LocationKey(context.Context, domain.Coordinates) (string, error) {
+	s.calls++
+
+	if s.err != nil {
+		return "", s.err
+	}
+
+	return s.provider + ":cell", nil
+}
+
+// TestClient_Failover tests provider ordering and failover between providers.
+func TestClient_Failover(t *testing.T) {
+	coords := domain.Coordinates{Latitude: 51.5074, Longitude: -0.1278}
+	unavailable := errors.New("NWS API returned status 503")
+
+	t.Run("first provider serves the request", func(t *testing.T) {
+		primary := &stubClient{provider: "nws"}
+		secondary := &stubClient{provider: "openmeteo"}
+		client := NewClient(zap.NewNop(), Provider{Name: "nws", Client: primary}, Provider{Name: "openmeteo", Client: secondary})
+
+		data, err := client.GetForecast(context.Background(), coords)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "nws", data.Provider)
+		assert.Equal(t, 0, secondary.calls)
+	})
+
+	t.Run("fails over when a provider errors", func(t *testing.T) {
+		primary := &stubClient{err: unavailable}
+		secondary := &stubClient{provider: "openmeteo"}
+		client := NewClient(zap.NewNop(), Provider{Name: "nws", Client: primary}, Provider{Name: "openmeteo", Client: secondary})
+
+		forecast, err := client.GetHourlyForecast(context.Background(), coords)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "openmeteo", forecast.Provider)
+		assert.Equal(t, 1, primary.calls)
+	})
+
+	t.Run("fails over when a breaker is open", func(t *testing.T) {
+		client := NewClient(zap.NewNop(),
+			Provider{Name: "nws", Client: &stubClient{err: gobreaker.ErrOpenState}},
+			Provider{Name: "openmeteo", Client: &stubClient{provider: "openmeteo"}},
+		)
+
+		observation, err := client.GetObservation(context.Background(), coords)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "openmeteo", observation.Provider)
+	})
+
+	t.Run("returns a 304 without failing over", func(t *testing.T) {
+		notModified := &ports.NotModifiedError{}
+		secondary := &stubClient{provider: "openmeteo"}
+		client := NewClient(zap.NewNop(), Provider{Name: "nws", Client: &stubClient{err: notModified}}, Provider{Name: "openmeteo", Client: secondary})
+
+		_, err := client.GetForecastPeriods(context.Background(), coords)
+
+		assert.ErrorIs(t, err, notModified)
+		assert.Equal(t, 0, secondary.calls)
+	})
+
+	t.Run("reports every failure when all providers fail", func(t *testing.T) {
+		timeout := errors.New("context deadline exceeded")
+		client := NewClient(zap.NewNop(),
+			Provider{Name: "nws", Client: &stubClient{err: unavailable}},
+			Provider{Name: "openmeteo", Client: &stubClient{err: timeout}},
+		)
+
+		_, err := client.GetAlerts(context.Background(), coords)
+
+		assert.ErrorIs(t, err, unavailable)
+		assert.ErrorIs(t, err, timeout)
+		assert.Contains(t, err.Error(), "nws:")
+		assert.Contains(t, err.Error(), "openmeteo:")
+	})
+
+	t.Run("stops once the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		primary := &stubClient{err: unavailable}
+		secondary := &stubClient{provider: "openmeteo"}
+		client := NewClient(zap.NewNop(), Provider{Name: "nws", Client: primary}, Provider{Name: "openmeteo", Client: secondary})
+
+		cancel()
+
+		_, err := client.GetForecastPeriods(ctx, coords)
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 0, primary.calls)
+		assert.Equal(t, 0, secondary.calls)
+	})
+
+	t.Run("no providers", func(t *testing.T) {
+		_, err := NewClient(zap.NewNop()).GetForecast(context.Background(), coords)
+
+		assert.Error(t, err)
+	})
+}
+
+// usOnly is a coverage that contains points in the western hemisphere north of the equator.
+type usOnly struct{}
+
+// Contains reports whether the point is in the north-western quadrant.
+func (usOnly) Contains(coords domain.Coordinates) bool {
+	return coords.Latitude > 0 && coords.Longitude < 0
+}
+
+// TestClient_Coverage tests routing by coverage and unsupported locations.
+func TestClient_Coverage(t *testing.T) {
+	denver := domain.Coordinates{Latitude: 39.7392, Longitude: -104.9903}
+	paris := domain.Coordinates{Latitude: 48.8566, Longitude: 2.3522}
+	unsupported := fmt.Errorf("%w: no forecast grid", domain.ErrLocationNotSupported)
+
+	t.Run("skips providers that do not cover the point", func(t *testing.T) {
+		nws := &stubClient{provider: "nws"}
+		openmeteo := &stubClient{provider: "openmeteo"}
+		client := NewClient(zap.NewNop(),
+			Provider{Name: "nws", Client: nws, Coverage: usOnly{}},
+			Provider{Name: "openmeteo", Client: openmeteo},
+		)
+
+		data, err := client.GetForecast(context.Background(), paris)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "openmeteo", data.Provider)
+		assert.Equal(t, 0, nws.calls, "an uncovered provider is never called")
+
+		data, err = client.GetForecast(context.Background(), denver)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "nws", data.Provider)
+
+		key, err := client.LocationKey(context.Background(), paris)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "openmeteo:cell", key, "the location key comes from the provider that serves the point")
+	})
+
+	t.Run("rejects points no provider covers", func(t *testing.T) {
+		nws := &stubClient{provider: "nws"}
+		client := NewClient(zap.NewNop(), Provider{Name: "nws", Client: nws, Coverage: usOnly{}})
+
+		_, err := client.GetObservation(context.Background(), paris)
+
+		assert.ErrorIs(t, err, domain.ErrLocationNotSupported)
+		assert.Equal(t, 0, nws.calls)
+	})
+
+	t.Run("skips providers that report the location unsupported", func(t *testing.T) {
+		client := NewClient(zap.NewNop(),
+			Provider{Name: "nws", Client: &stubClient{err: unsupported}},
+			Provider{Name: "openmeteo", Client: &stubClient{provider: "openmeteo"}},
+		)
+
+		data, err := client.GetForecast(context.Background(), denver)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "openmeteo", data.Provider)
+	})
+
+	t.Run("unsupported everywhere", func(t *testing.T) {
+		client := NewClient(zap.NewNop(),
+			Provider{Name: "nws", Client: &stubClient{provider: "nws"}, Coverage: usOnly{}},
+			Provider{Name: "openmeteo", Client: &stubClient{err: unsupported}},
+		)
+
+		_, err := client.GetAlerts(context.Background(), paris)
+
+		assert.ErrorIs(t, err, domain.ErrLocationNotSupported)
+	})
+
+	t.Run("a provider failure outranks unsupported", func(t *testing.T) {
+		unavailable := errors.New("NWS API returned status 503")
+		client := NewClient(zap.NewNop(),
+			Provider{Name: "nws", Client: &stubClient{err: unavailable}},
+			Provider{Name: "openmeteo", Client: &stubClient{err: unsupported}},
+		)
+
+		_, err := client.GetAlerts(context.Background(), denver)
+
+		assert.ErrorIs(t, err, unavailable)
+		assert.NotErrorIs(t, err, domain.ErrLocationNotSupported)
+	})
+}
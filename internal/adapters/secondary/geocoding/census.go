@@ -0,0 +1,157 @@
+package geocoding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+)
+
+// censusSource is the Source reported for places resolved by the Census geocoder.
+const censusSource = "census"
+
+// CensusGeocoder resolves addresses with the US Census Bureau geocoding API
+// (https://geocoding.geo.census.gov). Any service exposing the same
+// onelineaddress endpoint can be used by changing the base URL.
+type CensusGeocoder struct {
+	// baseURL is the geocoder base endpoint
+	baseURL string
+
+	// benchmark selects the Census address dataset
+	benchmark string
+
+	// httpClient handles HTTP communication
+	httpClient *http.Client
+
+	// userAgent identifies this service, and how to contact its operator, to the Census geocoder
+	userAgent string
+
+	// logger records API interactions and errors
+	logger *zap.Logger
+}
+
+// censusResponse represents the response from /geocoder/locations/onelineaddress.
+type censusResponse struct {
+	Result struct {
+		AddressMatches []struct {
+			MatchedAddress string `json:"matchedAddress"`
+			Coordinates    struct {
+				X float64 `json:"x"`
+				Y float64 `json:"y"`
+			} `json:"coordinates"`
+			AddressComponents struct {
+				City  string `json:"city"`
+				State string `json:"state"`
+				Zip   string `json:"zip"`
+			} `json:"addressComponents"`
+		} `json:"addressMatches"`
+	} `json:"result"`
+}
+
+// NewCensusGeocoder creates a geocoder for the Census onelineaddress API.
+//
+// Parameters:
+//   - baseURL: Geocoder base URL (typically https://geocoding.geo.census.gov)
+//   - httpClient: HTTP client with timeout configuration
+//   - userAgent: User-Agent header value, such as "WeatherService/1.0 (ops@example.com)"
+//   - logger: Zap logger for API interaction logging
+//
+// Returns:
+//   - *CensusGeocoder: Configured geocoder
+func NewCensusGeocoder(baseURL string, httpClient *http.Client, userAgent string, logger *zap.Logger) *CensusGeocoder {
+	return &CensusGeocoder{
+		baseURL:    baseURL,
+		benchmark:  "Public_AR_Current",
+		httpClient: httpClient,
+		userAgent:  userAgent,
+		logger:     logger,
+	}
+}
+
+// Geocode resolves a street address or other free-text location. The
+// onelineaddress endpoint only matches street addresses, so ZIP code and city
+// queries are reported as not found without a request; they are resolved by
+// the gazetteer or OpenMeteoGeocoder instead.
+//
+// Parameters:
+//   - ctx: Context for cancellation (auto-adds 10s timeout if none)
+//   - query: Validated location query
+//
+// Returns:
+//   - *domain.Place: Best address match
+//   - error: Wraps domain.ErrLocationNotFound if there are no matches;
+//     otherwise an HTTP or decode error
+func (g *CensusGeocoder) Geocode(ctx context.Context, query domain.LocationQuery) (*domain.Place, error) {
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+	}
+
+	if _, _, _, isPlace := placeQuery(query); isPlace {
+		return nil, fmt.Errorf("%w: %q is not a street address", domain.ErrLocationNotFound, query.String())
+	}
+
+	address := query.Text
+
+	params := url.Values{}
+	params.Set("address", address)
+	params.Set("benchmark", g.benchmark)
+	params.Set("format", "json")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", g.baseURL+"/geocoder/locations/onelineaddress?"+params.Encode(), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", g.userAgent)
+
+	resp, err := g.httpClient.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+
+		if err != nil {
+			g.logger.Error("failed to close response body", zap.Error(err))
+		}
+	}(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("census geocoder returned status %d", resp.StatusCode)
+	}
+
+	var result censusResponse
+
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode census geocoder response: %w", err)
+	}
+
+	if len(result.Result.AddressMatches) == 0 {
+		return nil, fmt.Errorf("%w: %q", domain.ErrLocationNotFound, address)
+	}
+
+	match := result.Result.AddressMatches[0]
+
+	return &domain.Place{
+		Name:       match.MatchedAddress,
+		State:      match.AddressComponents.State,
+		PostalCode: match.AddressComponents.Zip,
+		Coordinates: domain.Coordinates{
+			Latitude:  match.Coordinates.Y,
+			Longitude: match.Coordinates.X,
+		},
+		Source: censusSource,
+	}, nil
+}
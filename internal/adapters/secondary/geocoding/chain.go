@@ -0,0 +1,59 @@
+package geocoding
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// Chain tries geocoders in order and returns the first match, so that cheap
+// offline lookups can be tried before remote services.
+type Chain struct {
+	// geocoders are tried in order
+	geocoders []ports.Geocoder
+}
+
+// NewChain creates a geocoder that tries each geocoder in turn.
+//
+// Parameters:
+//   - geocoders: Geocoders in the order they should be tried
+//
+// Returns:
+//   - *Chain: Chained geocoder
+func NewChain(geocoders ...ports.Geocoder) *Chain {
+	return &Chain{geocoders: geocoders}
+}
+
+// Geocode returns the first match from the chained geocoders.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - query: Validated location query
+//
+// Returns:
+//   - *domain.Place: First match
+//   - error: The last provider failure if any geocoder failed, otherwise an
+//     error wrapping domain.ErrLocationNotFound
+func (c *Chain) Geocode(ctx context.Context, query domain.LocationQuery) (*domain.Place, error) {
+	var lastErr error
+
+	for _, geocoder := range c.geocoders {
+		place, err := geocoder.Geocode(ctx, query)
+
+		if err == nil {
+			return place, nil
+		}
+
+		if !errors.Is(err, domain.ErrLocationNotFound) || lastErr == nil {
+			lastErr = err
+		}
+	}
+
+	if lastErr == nil {
+		lastErr = domain.ErrLocationNotFound
+	}
+
+	return nil, lastErr
+}
@@ -0,0 +1,240 @@
+// Package geocoding implements the Geocoder secondary port.
+// It provides an offline gazetteer of US ZIP codes and places, HTTP clients
+// for the Open-Meteo place search and the US Census address geocoder, and a
+// chain that tries geocoders in order.
+package geocoding
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+)
+
+// gazetteerSource is the Source reported for places resolved by the gazetteer.
+const gazetteerSource = "gazetteer"
+
+// embeddedGazetteer is the built-in gazetteer: centroids for major US places
+// and a selection of their ZIP codes. Larger datasets in the same format can
+// be loaded with LoadGazetteerFile.
+//
+//go:embed gazetteer.csv
+var embeddedGazetteer []byte
+
+// Gazetteer resolves ZIP codes and city names offline from an in-memory table.
+type Gazetteer struct {
+	// zips maps five-digit ZIP codes to their centroids
+	zips map[string]domain.Place
+
+	// places maps normalized "name|ST" keys to place centroids
+	places map[string]domain.Place
+}
+
+// NewGazetteer creates a gazetteer from the embedded dataset.
+//
+// Returns:
+//   - *Gazetteer: Offline geocoder
+//   - error: Parse error if the embedded dataset is malformed
+func NewGazetteer() (*Gazetteer, error) {
+	return LoadGazetteer(bytes.NewReader(embeddedGazetteer))
+}
+
+// LoadGazetteerFile creates a gazetteer from a CSV file on disk.
+//
+// Parameters:
+//   - path: Path to a CSV file in the embedded gazetteer format
+//
+// Returns:
+//   - *Gazetteer: Offline geocoder
+//   - error: File open or parse error
+func LoadGazetteerFile(path string) (*Gazetteer, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to open gazetteer: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	return LoadGazetteer(f)
+}
+
+// LoadGazetteer creates a gazetteer from CSV data with the header
+// "type,code,name,state,latitude,longitude". Rows of type "zip" carry the ZIP
+// code in the code column; rows of type "place" leave it empty.
+//
+// Parameters:
+//   - r: CSV data
+//
+// Returns:
+//   - *Gazetteer: Offline geocoder
+//   - error: Parse error identifying the offending line
+func LoadGazetteer(r io.Reader) (*Gazetteer, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 6
+
+	if _, err := reader.Read(); err != nil {
+		return nil, fmt.Errorf("failed to read gazetteer header: %w", err)
+	}
+
+	g := &Gazetteer{
+		zips:   make(map[string]domain.Place),
+		places: make(map[string]domain.Place),
+	}
+
+	for {
+		record, err := reader.Read()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read gazetteer: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		latitude, latErr := strconv.ParseFloat(record[4], 64)
+		longitude, lonErr := strconv.ParseFloat(record[5], 64)
+
+		if latErr != nil || lonErr != nil {
+			return nil, fmt.Errorf("gazetteer line %d: invalid coordinates", line)
+		}
+
+		place := domain.Place{
+			Name:        record[2],
+			State:       strings.ToUpper(record[3]),
+			Coordinates: domain.Coordinates{Latitude: latitude, Longitude: longitude},
+			Source:      gazetteerSource,
+		}
+
+		switch record[0] {
+		case "zip":
+			if !domain.IsPostalCode(record[1]) {
+				return nil, fmt.Errorf("gazetteer line %d: invalid ZIP code %q", line, record[1])
+			}
+
+			place.PostalCode = record[1]
+			g.zips[record[1]] = place
+		case "place":
+			g.places[placeKey(place.Name, place.State)] = place
+		default:
+			return nil, fmt.Errorf("gazetteer line %d: unknown type %q", line, record[0])
+		}
+	}
+
+	return g, nil
+}
+
+// Geocode resolves a ZIP code, city and state, or free text of the form
+// "12345", "City, ST" or "City ST". Street addresses are not supported.
+//
+// Parameters:
+//   - ctx: Context (unused; lookups are in memory)
+//   - query: Validated location query
+//
+// Returns:
+//   - *domain.Place: Matched place
+//   - error: Wraps domain.ErrLocationNotFound if nothing matches
+func (g *Gazetteer) Geocode(_ context.Context, query domain.LocationQuery) (*domain.Place, error) {
+	switch {
+	case query.PostalCode != "":
+		return g.lookupZip(query.PostalCode)
+	case query.City != "":
+		return g.lookupPlace(query.City, query.State)
+	}
+
+	text := strings.TrimSpace(query.Text)
+
+	if domain.IsPostalCode(text) {
+		return g.lookupZip(text)
+	}
+
+	if city, state, ok := splitCityState(text); ok {
+		return g.lookupPlace(city, state)
+	}
+
+	return nil, fmt.Errorf("%w: %q", domain.ErrLocationNotFound, text)
+}
+
+// lookupZip finds a ZIP code centroid.
+func (g *Gazetteer) lookupZip(zip string) (*domain.Place, error) {
+	place, ok := g.zips[zip]
+
+	if !ok {
+		return nil, fmt.Errorf("%w: ZIP %s", domain.ErrLocationNotFound, zip)
+	}
+
+	return &place, nil
+}
+
+// lookupPlace finds a place centroid by name and state.
+func (g *Gazetteer) lookupPlace(city, state string) (*domain.Place, error) {
+	abbr, ok := stateAbbreviation(state)
+
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown state %q", domain.ErrLocationNotFound, state)
+	}
+
+	place, ok := g.places[placeKey(city, abbr)]
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s, %s", domain.ErrLocationNotFound, city, abbr)
+	}
+
+	return &place, nil
+}
+
+// splitCityState splits "City, ST", "City, State 12345" or "City ST" into
+// a city and state. The state may be an abbreviation or a full name.
+func splitCityState(text string) (string, string, bool) {
+	if i := strings.LastIndex(text, ","); i >= 0 {
+		city := strings.TrimSpace(text[:i])
+		state := strings.Fields(text[i+1:])
+
+		// Drop a trailing ZIP code, as in "Denver, CO 80202"
+		if n := len(state); n > 1 && domain.IsPostalCode(state[n-1]) {
+			state = state[:n-1]
+		}
+
+		if _, ok := stateAbbreviation(strings.Join(state, " ")); ok && city != "" {
+			return city, strings.Join(state, " "), true
+		}
+
+		return "", "", false
+	}
+
+	fields := strings.Fields(text)
+
+	if len(fields) < 2 {
+		return "", "", false
+	}
+
+	if _, ok := stateAbbreviation(fields[len(fields)-1]); ok {
+		return strings.Join(fields[:len(fields)-1], " "), fields[len(fields)-1], true
+	}
+
+	return "", "", false
+}
+
+// placeKey normalizes a place name and state abbreviation into a lookup key.
+// "Saint" and "St." are treated alike.
+func placeKey(name, state string) string {
+	name = strings.ToLower(strings.Join(strings.Fields(name), " "))
+
+	for _, prefix := range []string{"saint ", "st "} {
+		if strings.HasPrefix(name, prefix) {
+			name = "st. " + strings.TrimPrefix(name, prefix)
+		}
+	}
+
+	return name + "|" + strings.ToUpper(state)
+}
@@ -0,0 +1,435 @@
+// Package geocoding contains unit tests for the geocoders.
+package geocoding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+)
+
+// testUserAgent is the User-Agent the clients under test are configured to send.
+const testUserAgent = "acme-weather/2.3 (ops@example.com)"
+
+// TestGazetteer_Geocode tests offline lookups against the embedded gazetteer.
+func TestGazetteer_Geocode(t *testing.T) {
+	gazetteer, err := NewGazetteer()
+
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		query         domain.LocationQuery
+		expectedName  string
+		expectedState string
+		expectedZip   string
+		notFound      bool
+	}{
+		{
+			name:          "zip code",
+			query:         domain.LocationQuery{PostalCode: "80202"},
+			expectedName:  "Denver",
+			expectedState: "CO",
+			expectedZip:   "80202",
+		},
+		{
+			name:          "city and state abbreviation",
+			query:         domain.LocationQuery{City: "denver", State: "co"},
+			expectedName:  "Denver",
+			expectedState: "CO",
+		},
+		{
+			name:          "city and full state name",
+			query:         domain.LocationQuery{City: "Portland", State: "Maine"},
+			expectedName:  "Portland",
+			expectedState: "ME",
+		},
+		{
+			name:          "free text with comma",
+			query:         domain.LocationQuery{Text: "Salt Lake City, Utah"},
+			expectedName:  "Salt Lake City",
+			expectedState: "UT",
+		},
+		{
+			name:          "free text with trailing zip",
+			query:         domain.LocationQuery{Text: "Kansas City, MO 64106"},
+			expectedName:  "Kansas City",
+			expectedState: "MO",
+		},
+		{
+			name:          "free text without comma",
+			query:         domain.LocationQuery{Text: "new orleans la"},
+			expectedName:  "New Orleans",
+			expectedState: "LA",
+		},
+		{
+			name:          "saint abbreviation",
+			query:         domain.LocationQuery{Text: "Saint Louis, MO"},
+			expectedName:  "St. Louis",
+			expectedState: "MO",
+		},
+		{
+			name:          "free text zip code",
+			query:         domain.LocationQuery{Text: " 98101 "},
+			expectedName:  "Seattle",
+			expectedState: "WA",
+			expectedZip:   "98101",
+		},
+		{
+			name:     "unknown zip code",
+			query:    domain.LocationQuery{PostalCode: "00000"},
+			notFound: true,
+		},
+		{
+			name:     "unknown state",
+			query:    domain.LocationQuery{City: "Denver", State: "XX"},
+			notFound: true,
+		},
+		{
+			name:     "street address",
+			query:    domain.LocationQuery{Text: "1600 Pennsylvania Ave NW"},
+			notFound: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			place, err := gazetteer.Geocode(context.Background(), tt.query)
+
+			if tt.notFound {
+				assert.ErrorIs(t, err, domain.ErrLocationNotFound)
+				assert.Nil(t, place)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedName, place.Name)
+			assert.Equal(t, tt.expectedState, place.State)
+			assert.Equal(t, tt.expectedZip, place.PostalCode)
+			assert.Equal(t, "gazetteer", place.Source)
+			assert.NoError(t, place.Coordinates.Validate())
+		})
+	}
+}
+
+// TestLoadGazetteer tests parsing errors in gazetteer data.
+func TestLoadGazetteer(t *testing.T) {
+	header := "type,code,name,state,latitude,longitude\n"
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "invalid coordinates", data: header + "place,,Denver,CO,north,-104.99\n"},
+		{name: "invalid zip", data: header + "zip,8020,Denver,CO,39.75,-104.99\n"},
+		{name: "unknown type", data: header + "county,,Denver,CO,39.75,-104.99\n"},
+		{name: "wrong column count", data: header + "place,Denver,CO,39.75,-104.99\n"},
+		{name: "empty", data: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gazetteer, err := LoadGazetteer(strings.NewReader(tt.data))
+
+			assert.Error(t, err)
+			assert.Nil(t, gazetteer)
+		})
+	}
+}
+
+// TestCensusGeocoder_Geocode tests decoding of Census geocoder responses.
+func TestCensusGeocoder_Geocode(t *testing.T) {
+	t.Run("returns the first match", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/geocoder/locations/onelineaddress", r.URL.Path)
+			assert.Equal(t, "1600 Pennsylvania Ave NW, Washington, DC", r.URL.Query().Get("address"))
+			assert.Equal(t, "json", r.URL.Query().Get("format"))
+			assert.Equal(t, testUserAgent, r.Header.Get("User-Agent"))
+
+			_, _ = fmt.Fprint(w, `{"result":{"addressMatches":[{
+				"matchedAddress":"1600 PENNSYLVANIA AVE NW, WASHINGTON, DC, 20500",
+				"coordinates":{"x":-77.03518753691,"y":38.89869893252},
+				"addressComponents":{"city":"WASHINGTON","state":"DC","zip":"20500"}
+			}]}}`)
+		}))
+		defer server.Close()
+
+		geocoder := NewCensusGeocoder(server.URL, server.Client(), testUserAgent, zap.NewNop())
+		place, err := geocoder.Geocode(context.Background(), domain.LocationQuery{Text: "1600 Pennsylvania Ave NW, Washington, DC"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1600 PENNSYLVANIA AVE NW, WASHINGTON, DC, 20500", place.Name)
+		assert.Equal(t, "DC", place.State)
+		assert.Equal(t, "20500", place.PostalCode)
+		assert.InDelta(t, 38.8987, place.Coordinates.Latitude, 0.0001)
+		assert.InDelta(t, -77.0352, place.Coordinates.Longitude, 0.0001)
+		assert.Equal(t, "census", place.Source)
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, `{"result":{"addressMatches":[]}}`)
+		}))
+		defer server.Close()
+
+		geocoder := NewCensusGeocoder(server.URL, server.Client(), testUserAgent, zap.NewNop())
+		_, err := geocoder.Geocode(context.Background(), domain.LocationQuery{Text: "nowhere"})
+
+		assert.ErrorIs(t, err, domain.ErrLocationNotFound)
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		geocoder := NewCensusGeocoder(server.URL, server.Client(), testUserAgent, zap.NewNop())
+		_, err := geocoder.Geocode(context.Background(), domain.LocationQuery{Text: "nowhere"})
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, domain.ErrLocationNotFound)
+	})
+
+	t.Run("leaves ZIP and city queries to other geocoders", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+		}))
+		defer server.Close()
+
+		geocoder := NewCensusGeocoder(server.URL, server.Client(), testUserAgent, zap.NewNop())
+
+		for _, query := range []domain.LocationQuery{
+			{PostalCode: "59801"},
+			{City: "Missoula", State: "MT"},
+			{Text: "59801"},
+			{Text: "Missoula, Montana"},
+		} {
+			_, err := geocoder.Geocode(context.Background(), query)
+
+			assert.ErrorIs(t, err, domain.ErrLocationNotFound)
+		}
+
+		assert.Equal(t, 0, requests)
+	})
+}
+
+// openMeteoSearch is a canned /v1/search response with one US and one foreign
+// place sharing a name, and a second US place whose ZIP codes differ.
+const openMeteoSearch = `{"results":[
+	{"name":"Missoula","latitude":46.87215,"longitude":-113.99399,"country_code":"CA","admin1":"Ontario","postcodes":["59801"]},
+	{"name":"Lolo","latitude":46.75882,"longitude":-114.08094,"country_code":"US","admin1":"Montana","postcodes":["59847"]},
+	{"name":"Missoula","latitude":46.87215,"longitude":-113.99399,"country_code":"US","admin1":"Montana","postcodes":["59801","59802","59803"]}
+]}`
+
+// TestOpenMeteoGeocoder_Geocode tests ZIP and place lookups against the Open-Meteo search API.
+func TestOpenMeteoGeocoder_Geocode(t *testing.T) {
+	tests := []struct {
+		name         string
+		query        domain.LocationQuery
+		expectedName string
+		expectedZip  string
+		expectedTerm string
+	}{
+		{
+			name:         "zip code",
+			query:        domain.LocationQuery{PostalCode: "59802"},
+			expectedName: "Missoula",
+			expectedZip:  "59802",
+			expectedTerm: "59802",
+		},
+		{
+			name:         "city and full state name",
+			query:        domain.LocationQuery{City: "missoula", State: "Montana"},
+			expectedName: "Missoula",
+			expectedTerm: "missoula",
+		},
+		{
+			name:         "free text",
+			query:        domain.LocationQuery{Text: "Missoula, MT 59801"},
+			expectedName: "Missoula",
+			expectedTerm: "Missoula",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/v1/search", r.URL.Path)
+				assert.Equal(t, tt.expectedTerm, r.URL.Query().Get("name"))
+				assert.Equal(t, "US", r.URL.Query().Get("countryCode"))
+				assert.Equal(t, testUserAgent, r.Header.Get("User-Agent"))
+
+				_, _ = fmt.Fprint(w, openMeteoSearch)
+			}))
+			defer server.Close()
+
+			geocoder := NewOpenMeteoGeocoder(server.URL, server.Client(), testUserAgent, zap.NewNop())
+			place, err := geocoder.Geocode(context.Background(), tt.query)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedName, place.Name)
+			assert.Equal(t, "MT", place.State)
+			assert.Equal(t, tt.expectedZip, place.PostalCode)
+			assert.InDelta(t, 46.8722, place.Coordinates.Latitude, 0.0001)
+			assert.InDelta(t, -113.9940, place.Coordinates.Longitude, 0.0001)
+			assert.Equal(t, "openmeteo", place.Source)
+		})
+	}
+
+	t.Run("no US match", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, openMeteoSearch)
+		}))
+		defer server.Close()
+
+		geocoder := NewOpenMeteoGeocoder(server.URL, server.Client(), testUserAgent, zap.NewNop())
+
+		for _, query := range []domain.LocationQuery{
+			{PostalCode: "10001"},
+			{City: "Missoula", State: "ID"},
+		} {
+			_, err := geocoder.Geocode(context.Background(), query)
+
+			assert.ErrorIs(t, err, domain.ErrLocationNotFound)
+		}
+	})
+
+	t.Run("no results", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, `{"generationtime_ms":0.5}`)
+		}))
+		defer server.Close()
+
+		geocoder := NewOpenMeteoGeocoder(server.URL, server.Client(), testUserAgent, zap.NewNop())
+		_, err := geocoder.Geocode(context.Background(), domain.LocationQuery{PostalCode: "59801"})
+
+		assert.ErrorIs(t, err, domain.ErrLocationNotFound)
+	})
+
+	t.Run("street addresses are not sent", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+		}))
+		defer server.Close()
+
+		geocoder := NewOpenMeteoGeocoder(server.URL, server.Client(), testUserAgent, zap.NewNop())
+		_, err := geocoder.Geocode(context.Background(), domain.LocationQuery{Text: "1600 Pennsylvania Ave NW, Washington, DC"})
+
+		assert.ErrorIs(t, err, domain.ErrLocationNotFound)
+		assert.Equal(t, 0, requests)
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		geocoder := NewOpenMeteoGeocoder(server.URL, server.Client(), testUserAgent, zap.NewNop())
+		_, err := geocoder.Geocode(context.Background(), domain.LocationQuery{PostalCode: "59801"})
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, domain.ErrLocationNotFound)
+	})
+}
+
+// TestChain_ZipOutsideGazetteer tests that the default chain resolves a ZIP code
+// missing from the embedded gazetteer without asking the Census address geocoder.
+func TestChain_ZipOutsideGazetteer(t *testing.T) {
+	gazetteer, err := NewGazetteer()
+
+	assert.NoError(t, err)
+
+	_, err = gazetteer.Geocode(context.Background(), domain.LocationQuery{PostalCode: "59801"})
+
+	assert.ErrorIs(t, err, domain.ErrLocationNotFound)
+
+	places := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, openMeteoSearch)
+	}))
+	defer places.Close()
+
+	censusRequests := 0
+	census := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		censusRequests++
+	}))
+	defer census.Close()
+
+	chain := NewChain(
+		gazetteer,
+		NewOpenMeteoGeocoder(places.URL, places.Client(), testUserAgent, zap.NewNop()),
+		NewCensusGeocoder(census.URL, census.Client(), testUserAgent, zap.NewNop()),
+	)
+
+	place, err := chain.Geocode(context.Background(), domain.LocationQuery{PostalCode: "59801"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Missoula", place.Name)
+	assert.Equal(t, "MT", place.State)
+	assert.Equal(t, "openmeteo", place.Source)
+	assert.Equal(t, 0, censusRequests)
+}
+
+// stubGeocoder returns a fixed place or error.
+type stubGeocoder struct {
+	place *domain.Place
+	err   error
+	calls int
+}
+
+// Geocode returns the stub's place or error.
+func (s *stubGeocoder) Geocode(context.Context, domain.LocationQuery) (*domain.Place, error) {
+	s.calls++
+
+	return s.place, s.err
+}
+
+// TestChain_Geocode tests fallthrough between chained geocoders.
+func TestChain_Geocode(t *testing.T) {
+	query := domain.LocationQuery{Text: "Denver, CO"}
+	denver := &domain.Place{Name: "Denver", Source: "second"}
+	notFound := fmt.Errorf("%w: Denver", domain.ErrLocationNotFound)
+	unavailable := errors.New("connection refused")
+
+	t.Run("first match wins", func(t *testing.T) {
+		first := &stubGeocoder{place: &domain.Place{Name: "Denver", Source: "first"}}
+		second := &stubGeocoder{place: denver}
+
+		place, err := NewChain(first, second).Geocode(context.Background(), query)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "first", place.Source)
+		assert.Equal(t, 0, second.calls)
+	})
+
+	t.Run("falls through when not found", func(t *testing.T) {
+		place, err := NewChain(&stubGeocoder{err: notFound}, &stubGeocoder{place: denver}).Geocode(context.Background(), query)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "second", place.Source)
+	})
+
+	t.Run("reports provider failure over not found", func(t *testing.T) {
+		_, err := NewChain(&stubGeocoder{err: notFound}, &stubGeocoder{err: unavailable}).Geocode(context.Background(), query)
+
+		assert.ErrorIs(t, err, unavailable)
+	})
+
+	t.Run("not found everywhere", func(t *testing.T) {
+		_, err := NewChain(&stubGeocoder{err: notFound}, &stubGeocoder{err: notFound}).Geocode(context.Background(), query)
+
+		assert.ErrorIs(t, err, domain.ErrLocationNotFound)
+	})
+}
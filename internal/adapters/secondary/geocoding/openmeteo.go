@@ -0,0 +1,249 @@
+package geocoding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+)
+
+// openMeteoSource is the Source reported for places resolved by the Open-Meteo geocoder.
+const openMeteoSource = "openmeteo"
+
+// openMeteoResultLimit is the number of candidates requested per search. Common
+// place names recur across states, so one result is rarely enough.
+const openMeteoResultLimit = 100
+
+// OpenMeteoGeocoder resolves US ZIP codes and place names with the Open-Meteo
+// geocoding API (https://geocoding-api.open-meteo.com), which searches the
+// GeoNames database of populated places and their postal codes. Street
+// addresses are left to the Census geocoder.
+type OpenMeteoGeocoder struct {
+	// baseURL is the geocoding API base endpoint
+	baseURL string
+
+	// httpClient handles HTTP communication
+	httpClient *http.Client
+
+	// userAgent identifies this service, and how to contact its operator, to Open-Meteo
+	userAgent string
+
+	// logger records API interactions and errors
+	logger *zap.Logger
+}
+
+// openMeteoResponse represents the response from /v1/search.
+type openMeteoResponse struct {
+	Results []struct {
+		Name        string   `json:"name"`
+		Latitude    float64  `json:"latitude"`
+		Longitude   float64  `json:"longitude"`
+		CountryCode string   `json:"country_code"`
+		Admin1      string   `json:"admin1"`
+		Postcodes   []string `json:"postcodes"`
+	} `json:"results"`
+}
+
+// NewOpenMeteoGeocoder creates a geocoder for the Open-Meteo search API.
+//
+// Parameters:
+//   - baseURL: Geocoding API base URL (typically https://geocoding-api.open-meteo.com)
+//   - httpClient: HTTP client with timeout configuration
+//   - userAgent: User-Agent header value, such as "WeatherService/1.0 (ops@example.com)"
+//   - logger: Zap logger for API interaction logging
+//
+// Returns:
+//   - *OpenMeteoGeocoder: Configured geocoder
+func NewOpenMeteoGeocoder(baseURL string, httpClient *http.Client, userAgent string, logger *zap.Logger) *OpenMeteoGeocoder {
+	return &OpenMeteoGeocoder{
+		baseURL:    baseURL,
+		httpClient: httpClient,
+		userAgent:  userAgent,
+		logger:     logger,
+	}
+}
+
+// Geocode resolves a ZIP code or a city and state, given either as separate
+// fields or as free text such as "80202" or "Denver, CO". Other queries are
+// not sent and are reported as not found.
+//
+// Parameters:
+//   - ctx: Context for cancellation (auto-adds 10s timeout if none)
+//   - query: Validated location query
+//
+// Returns:
+//   - *domain.Place: Matching place in the US
+//   - error: Wraps domain.ErrLocationNotFound if there is no US match;
+//     otherwise an HTTP or decode error
+func (g *OpenMeteoGeocoder) Geocode(ctx context.Context, query domain.LocationQuery) (*domain.Place, error) {
+	zip, city, state, ok := placeQuery(query)
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %q is not a ZIP code or place", domain.ErrLocationNotFound, query.Text)
+	}
+
+	if zip != "" {
+		return g.lookupZip(ctx, zip)
+	}
+
+	return g.lookupPlace(ctx, city, state)
+}
+
+// lookupZip finds the place a ZIP code belongs to.
+func (g *OpenMeteoGeocoder) lookupZip(ctx context.Context, zip string) (*domain.Place, error) {
+	result, err := g.search(ctx, zip)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, match := range result.Results {
+		abbr, ok := stateAbbreviation(match.Admin1)
+
+		if match.CountryCode != "US" || !ok || !slices.Contains(match.Postcodes, zip) {
+			continue
+		}
+
+		return &domain.Place{
+			Name:       match.Name,
+			State:      abbr,
+			PostalCode: zip,
+			Coordinates: domain.Coordinates{
+				Latitude:  match.Latitude,
+				Longitude: match.Longitude,
+			},
+			Source: openMeteoSource,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: ZIP %s", domain.ErrLocationNotFound, zip)
+}
+
+// lookupPlace finds a place by name and state.
+func (g *OpenMeteoGeocoder) lookupPlace(ctx context.Context, city, state string) (*domain.Place, error) {
+	abbr, ok := stateAbbreviation(state)
+
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown state %q", domain.ErrLocationNotFound, state)
+	}
+
+	result, err := g.search(ctx, city)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, match := range result.Results {
+		matchState, ok := stateAbbreviation(match.Admin1)
+
+		if match.CountryCode != "US" || !ok || placeKey(match.Name, matchState) != placeKey(city, abbr) {
+			continue
+		}
+
+		return &domain.Place{
+			Name:  match.Name,
+			State: matchState,
+			Coordinates: domain.Coordinates{
+				Latitude:  match.Latitude,
+				Longitude: match.Longitude,
+			},
+			Source: openMeteoSource,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s, %s", domain.ErrLocationNotFound, city, abbr)
+}
+
+// search sends a US-only search for a name or postal code.
+func (g *OpenMeteoGeocoder) search(ctx context.Context, name string) (*openMeteoResponse, error) {
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+	}
+
+	params := url.Values{}
+	params.Set("name", name)
+	params.Set("countryCode", "US")
+	params.Set("count", strconv.Itoa(openMeteoResultLimit))
+	params.Set("language", "en")
+	params.Set("format", "json")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", g.baseURL+"/v1/search?"+params.Encode(), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", g.userAgent)
+
+	resp, err := g.httpClient.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+
+		if err != nil {
+			g.logger.Error("failed to close response body", zap.Error(err))
+		}
+	}(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("open-meteo geocoder returned status %d", resp.StatusCode)
+	}
+
+	var result openMeteoResponse
+
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode open-meteo geocoder response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// placeQuery reports whether a query names a ZIP code or a city and state
+// rather than a street address. Free text counts as a place only if it is a
+// bare ZIP code or splits into a city and state with no digits in the city,
+// so "1600 Pennsylvania Ave NW, Washington, DC" stays an address.
+//
+// Parameters:
+//   - query: Validated location query
+//
+// Returns:
+//   - string: ZIP code, empty for a city query
+//   - string: City name
+//   - string: State name or abbreviation
+//   - bool: false if the query is an address
+func placeQuery(query domain.LocationQuery) (string, string, string, bool) {
+	switch {
+	case query.PostalCode != "":
+		return query.PostalCode, "", "", true
+	case query.City != "":
+		return "", query.City, query.State, true
+	}
+
+	text := strings.TrimSpace(query.Text)
+
+	if domain.IsPostalCode(text) {
+		return text, "", "", true
+	}
+
+	if city, state, ok := splitCityState(text); ok && !strings.ContainsAny(city, "0123456789") {
+		return "", city, state, true
+	}
+
+	return "", "", "", false
+}
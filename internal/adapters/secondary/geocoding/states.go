@@ -0,0 +1,93 @@
+package geocoding
+
+import "strings"
+
+// stateNames maps lower-case US state, district and territory names to their
+// USPS abbreviations.
+var stateNames = map[string]string{
+	"alabama":              "AL",
+	"alaska":               "AK",
+	"arizona":              "AZ",
+	"arkansas":             "AR",
+	"california":           "CA",
+	"colorado":             "CO",
+	"connecticut":          "CT",
+	"delaware":             "DE",
+	"district of columbia": "DC",
+	"florida":              "FL",
+	"georgia":              "GA",
+	"guam":                 "GU",
+	"hawaii":               "HI",
+	"idaho":                "ID",
+	"illinois":             "IL",
+	"indiana":              "IN",
+	"iowa":                 "IA",
+	"kansas":               "KS",
+	"kentucky":             "KY",
+	"louisiana":            "LA",
+	"maine":                "ME",
+	"maryland":             "MD",
+	"massachusetts":        "MA",
+	"michigan":             "MI",
+	"minnesota":            "MN",
+	"mississippi":          "MS",
+	"missouri":             "MO",
+	"montana":              "MT",
+	"nebraska":             "NE",
+	"nevada":               "NV",
+	"new hampshire":        "NH",
+	"new jersey":           "NJ",
+	"new mexico":           "NM",
+	"new york":             "NY",
+	"north carolina":       "NC",
+	"north dakota":         "ND",
+	"ohio":                 "OH",
+	"oklahoma":             "OK",
+	"oregon":               "OR",
+	"pennsylvania":         "PA",
+	"puerto rico":          "PR",
+	"rhode island":         "RI",
+	"south carolina":       "SC",
+	"south dakota":         "SD",
+	"tennessee":            "TN",
+	"texas":                "TX",
+	"utah":                 "UT",
+	"vermont":              "VT",
+	"virgin islands":       "VI",
+	"virginia":             "VA",
+	"washington":           "WA",
+	"west virginia":        "WV",
+	"wisconsin":            "WI",
+	"wyoming":              "WY",
+}
+
+// stateAbbreviations is the set of valid USPS abbreviations.
+var stateAbbreviations = func() map[string]bool {
+	abbrs := make(map[string]bool, len(stateNames))
+
+	for _, abbr := range stateNames {
+		abbrs[abbr] = true
+	}
+
+	return abbrs
+}()
+
+// stateAbbreviation normalizes a state name or abbreviation to its USPS abbreviation.
+//
+// Parameters:
+//   - state: Abbreviation ("co") or full name ("Colorado"), case-insensitive
+//
+// Returns:
+//   - string: Upper-case abbreviation
+//   - bool: false if the state is not recognized
+func stateAbbreviation(state string) (string, bool) {
+	state = strings.TrimSpace(state)
+
+	if abbr := strings.ToUpper(state); stateAbbreviations[abbr] {
+		return abbr, true
+	}
+
+	abbr, ok := stateNames[strings.ToLower(strings.Join(strings.Fields(state), " "))]
+
+	return abbr, ok
+}
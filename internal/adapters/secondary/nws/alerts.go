@@ -0,0 +1,77 @@
+package nws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// alertsResponse represents the NWS API response from the /alerts/active endpoint.
+type alertsResponse struct {
+	Features []struct {
+		Properties struct {
+			ID            string     `json:"id"`
+			AreaDesc      string     `json:"areaDesc"`
+			AffectedZones []string   `json:"affectedZones"`
+			Onset         *time.Time `json:"onset"`
+			Expires       time.Time  `json:"expires"`
+			Severity      string     `json:"severity"`
+			Certainty     string     `json:"certainty"`
+			Urgency       string     `json:"urgency"`
+			Event         string     `json:"event"`
+			Headline      string     `json:"headline"`
+			Description   string     `json:"description"`
+			Instruction   string     `json:"instruction"`
+		} `json:"properties"`
+	} `json:"features"`
+}
+
+// GetAlerts retrieves active watches, warnings and advisories for a point from the NWS API.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout
+//   - coords: Geographic coordinates to check for alerts
+//
+// Returns:
+//   - []ports.AlertData: Active alerts, empty when none are in effect
+//   - error: HTTP error, non-200 status, or JSON decode error
+func (c *Client) GetAlerts(ctx context.Context, coords domain.Coordinates) ([]ports.AlertData, error) {
+	alertsURL := fmt.Sprintf("%s/alerts/active?point=%.4f,%.4f", c.alertsBaseURL, coords.Latitude, coords.Longitude)
+
+	var resp alertsResponse
+
+	if _, err := c.getJSON(ctx, alertsURL, nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to fetch alerts: %w", err)
+	}
+
+	alerts := make([]ports.AlertData, 0, len(resp.Features))
+
+	for _, f := range resp.Features {
+		props := f.Properties
+
+		alert := ports.AlertData{
+			ID:              props.ID,
+			Event:           props.Event,
+			Severity:        domain.AlertSeverity(props.Severity),
+			Urgency:         domain.AlertUrgency(props.Urgency),
+			Certainty:       domain.AlertCertainty(props.Certainty),
+			Headline:        props.Headline,
+			Description:     props.Description,
+			Instruction:     props.Instruction,
+			AreaDescription: props.AreaDesc,
+			AffectedZones:   props.AffectedZones,
+			Expires:         props.Expires,
+		}
+
+		if props.Onset != nil {
+			alert.Onset = *props.Onset
+		}
+
+		alerts = append(alerts, alert)
+	}
+
+	return alerts, nil
+}
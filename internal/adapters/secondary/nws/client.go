@@ -4,73 +4,233 @@
 package nws
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/sean-rowe/weather-service/internal/core/domain"
 	"github.com/sean-rowe/weather-service/internal/core/ports"
 )
 
+// ProviderName identifies the NWS in provider configuration and in the data it returns.
+const ProviderName = "nws"
+
 // Client implements the WeatherClient interface for the National Weather Service API.
 // It handles the two-step process required by NWS: first getting grid coordinates
-// from lat/lon, then fetching the actual forecast from the grid endpoint.
+// from lat/lon, then fetching the actual forecast from the grid endpoint. Grid
+// lookups are cached, so repeat requests for a location skip the first step, and
+// forecasts can be revalidated with conditional requests, so an unchanged forecast
+// is not downloaded again.
 type Client struct {
-	// baseURL is the NWS API base endpoint
-	baseURL string
+	// pointsBaseURL is the base endpoint of /points grid lookups
+	pointsBaseURL string
+
+	// alertsBaseURL is the base endpoint of /alerts
+	alertsBaseURL string
+
+	// stationsBaseURL is the base endpoint of /stations observations
+	stationsBaseURL string
+
+	// userAgent identifies this service, and how to contact its operator, to NWS
+	userAgent string
+
+	// accept is the media type requested from NWS
+	accept string
+
+	// featureFlags is the Feature-Flags header value; empty to omit the header
+	featureFlags string
 
 	// httpClient handles HTTP communication with timeout and retry logic
 	httpClient *http.Client
 
+	// grids caches /points lookups; nil disables grid caching
+	grids *gridCache
+
+	// conditionals counts conditional forecast requests by result
+	conditionals metric.Int64Counter
+
+	// retry decides which failed requests are retried and when
+	retry retryPolicy
+
 	// logger records API interactions and errors
 	logger *zap.Logger
 }
 
+// DefaultUserAgent is the product token sent to NWS when Config.UserAgent is not set.
+const DefaultUserAgent = "WeatherService/1.0"
+
+// FormatUserAgent builds the User-Agent header value sent to NWS, which other
+// outbound clients reuse so that the service identifies itself the same way everywhere.
+//
+// Parameters:
+//   - userAgent: Product token identifying this service; empty uses DefaultUserAgent
+//   - contact: Email address or URL of the operator; omitted if empty
+//
+// Returns:
+//   - string: Product token followed by the contact in parentheses, e.g.
+//     "WeatherService/1.0 (ops@example.com)"
+func FormatUserAgent(userAgent, contact string) string {
+	userAgent = cmp.Or(userAgent, DefaultUserAgent)
+
+	if contact == "" {
+		return userAgent
+	}
+
+	return fmt.Sprintf("%s (%s)", userAgent, contact)
+}
+
+// Config contains optional settings for the NWS client.
+type Config struct {
+	// UserAgent is the product token identifying this service (default: DefaultUserAgent)
+	UserAgent string
+
+	// Contact is an email address or URL where NWS can reach the operator. NWS asks
+	// for one and may block requests without it; it is sent in the User-Agent header.
+	Contact string
+
+	// Accept is the media type requested: MediaTypeGeoJSON or MediaTypeJSONLD
+	// (default: MediaTypeGeoJSON)
+	Accept string
+
+	// FeatureFlags lists NWS feature flags to opt into, sent in the Feature-Flags header
+	FeatureFlags []string
+
+	// PointsBaseURL overrides the base URL of /points grid lookups, e.g. to use a mirror
+	PointsBaseURL string
+
+	// AlertsBaseURL overrides the base URL of /alerts requests
+	AlertsBaseURL string
+
+	// StationsBaseURL overrides the base URL of /stations observation requests. Forecast
+	// and station list URLs are taken from the /points response as given.
+	StationsBaseURL string
+
+	// GridCacheTTL defines how long /points grid lookups are cached (default: 7 days)
+	GridCacheTTL time.Duration
+
+	// Meter records grid cache and conditional request counters (default: the global meter provider)
+	Meter metric.Meter
+
+	// Retry controls how failed requests are retried
+	Retry RetryConfig
+}
+
 // NewClient creates a new NWS API client with the specified configuration.
 //
 // Parameters:
-//   - baseURL: NWS API base URL (typically https://api.weather.gov)
+//   - baseURL: NWS API base URL (typically https://api.weather.gov), used for each
+//     endpoint whose base URL cfg does not override
 //   - httpClient: HTTP client with timeout and retry configuration
+//   - cache: CacheService for grid lookups; nil resolves the grid on every request
+//   - cfg: Client settings such as request headers, endpoint base URLs, cache TTLs and retry policy
 //   - logger: Zap logger for API interaction logging
 //
 // Returns:
 //   - *Client: Configured NWS API client
-func NewClient(baseURL string, httpClient *http.Client, logger *zap.Logger) *Client {
-	return &Client{
-		baseURL:    baseURL,
-		httpClient: httpClient,
-		logger:     logger,
+func NewClient(baseURL string, httpClient *http.Client, cache ports.CacheService, cfg Config, logger *zap.Logger) *Client {
+	userAgent := FormatUserAgent(cfg.UserAgent, cfg.Contact)
+
+	if cfg.Contact == "" {
+		logger.Warn("no NWS contact configured; NWS may block requests without one")
+	}
+
+	accept := cmp.Or(cfg.Accept, MediaTypeGeoJSON)
+
+	if accept != MediaTypeGeoJSON && accept != MediaTypeJSONLD {
+		logger.Warn("unknown NWS media type, using GeoJSON", zap.String("accept", accept))
+		accept = MediaTypeGeoJSON
+	}
+
+	client := &Client{
+		pointsBaseURL:   cmp.Or(cfg.PointsBaseURL, baseURL),
+		alertsBaseURL:   cmp.Or(cfg.AlertsBaseURL, baseURL),
+		stationsBaseURL: cmp.Or(cfg.StationsBaseURL, baseURL),
+		userAgent:       userAgent,
+		accept:          accept,
+		featureFlags:    strings.Join(cfg.FeatureFlags, ","),
+		httpClient:      httpClient,
+		conditionals:    newConditionalCounter(cfg.Meter, logger),
+		retry:           newRetryPolicy(cfg.Retry),
+		logger:          logger,
+	}
+
+	if cache != nil {
+		client.grids = newGridCache(cache, cfg.GridCacheTTL, cfg.Meter, logger)
 	}
+
+	return client
 }
 
 // pointsResponse represents the NWS API response from the /points endpoint.
 // This endpoint converts latitude/longitude coordinates to NWS grid coordinates.
 type pointsResponse struct {
 	Properties struct {
-		Forecast string `json:"forecast"`
+		GridID              string `json:"gridId"`
+		GridX               int    `json:"gridX"`
+		GridY               int    `json:"gridY"`
+		Forecast            string `json:"forecast"`
+		ForecastHourly      string `json:"forecastHourly"`
+		ObservationStations string `json:"observationStations"`
 	} `json:"properties"`
 }
 
 // forecastResponse represents the NWS API response from the forecast endpoint.
 type forecastResponse struct {
 	Properties struct {
-		Periods []forecastPeriod `json:"periods"`
+		GeneratedAt time.Time        `json:"generatedAt"`
+		UpdateTime  time.Time        `json:"updateTime"`
+		Periods     []forecastPeriod `json:"periods"`
 	} `json:"properties"`
 }
 
 // forecastPeriod represents a single time period in the weather forecast.
 type forecastPeriod struct {
-	Name            string `json:"name"`
-	Temperature     int    `json:"temperature"`
-	TemperatureUnit string `json:"temperatureUnit"`
-	ShortForecast   string `json:"shortForecast"`
+	Name                       string    `json:"name"`
+	StartTime                  time.Time `json:"startTime"`
+	EndTime                    time.Time `json:"endTime"`
+	IsDaytime                  bool      `json:"isDaytime"`
+	Temperature                int       `json:"temperature"`
+	TemperatureUnit            string    `json:"temperatureUnit"`
+	WindSpeed                  string    `json:"windSpeed"`
+	WindDirection              string    `json:"windDirection"`
+	ProbabilityOfPrecipitation quantity  `json:"probabilityOfPrecipitation"`
+	RelativeHumidity           quantity  `json:"relativeHumidity"`
+	Dewpoint                   quantity  `json:"dewpoint"`
+	Icon                       string    `json:"icon"`
+	ShortForecast              string    `json:"shortForecast"`
+	DetailedForecast           string    `json:"detailedForecast"`
+}
+
+// statusError reports a non-200 response from the NWS API.
+type statusError struct {
+	// statusCode is the HTTP status returned
+	statusCode int
+
+	// retryAfter is how long the Retry-After header asked clients to wait; zero if absent
+	retryAfter time.Duration
 }
 
+// Error implements the error interface for statusError.
+func (e *statusError) Error() string {
+	return fmt.Sprintf("NWS API returned status %d", e.statusCode)
+}
+
+// windSpeedPattern matches NWS wind speed strings such as "5 mph" or "10 to 15 mph".
+var windSpeedPattern = regexp.MustCompile(`^(\d+(?:\.\d+)?)(?:\s+to\s+(\d+(?:\.\d+)?))?\s*(mph|km/h)$`)
+
 // GetForecast retrieves weather forecast data from the NWS API.
 //
 // Parameters:
@@ -78,87 +238,299 @@ type forecastPeriod struct {
 //   - coords: Geographic coordinates for the forecast location
 //
 // Return:
-//   - *ports.WeatherData: Weather data including temperature and forecast text
+//   - *ports.WeatherData: Weather data including temperature, forecast text,
+//     wind, precipitation probability, humidity, dewpoint and icon
 //   - error: Returns error if coordinates are invalid, API is unavailable,
 //     or no forecast data is available
 func (c *Client) GetForecast(ctx context.Context, coords domain.Coordinates) (*ports.WeatherData, error) {
-	forecastURL, err := c.getForecastURL(ctx, coords)
+	periods, freshness, err := c.fetchPeriods(ctx, coords, false)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to get forecast URL: %w", err)
+		return nil, err
 	}
 
-	forecast, err := c.fetchForecast(ctx, forecastURL)
+	today := toPeriodData(periods[:1])[0]
+
+	return &ports.WeatherData{
+		Temperature:              today.Temperature,
+		Unit:                     today.Unit,
+		Forecast:                 today.ShortForecast,
+		DetailedForecast:         today.DetailedForecast,
+		Wind:                     today.Wind,
+		PrecipitationProbability: today.PrecipitationProbability,
+		RelativeHumidity:         today.RelativeHumidity,
+		Dewpoint:                 today.Dewpoint,
+		Icon:                     today.Icon,
+		Provider:                 ProviderName,
+		Freshness:                freshness,
+	}, nil
+}
+
+// GetForecastPeriods retrieves every named forecast period from the NWS API.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout
+//   - coords: Geographic coordinates for the forecast location
+//
+// Returns:
+//   - *ports.ForecastData: All forecast periods (typically 14, covering 7 days)
+//   - error: Returns error if coordinates are invalid, API is unavailable,
+//     or no forecast data is available
+func (c *Client) GetForecastPeriods(ctx context.Context, coords domain.Coordinates) (*ports.ForecastData, error) {
+	periods, freshness, err := c.fetchPeriods(ctx, coords, false)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch forecast: %w", err)
+		return nil, err
 	}
 
-	if len(forecast.Properties.Periods) == 0 {
-		return nil, fmt.Errorf("no forecast periods available")
+	return &ports.ForecastData{Periods: toPeriodData(periods), Provider: ProviderName, Freshness: freshness}, nil
+}
+
+// GetHourlyForecast retrieves the hourly forecast from the NWS forecastHourly endpoint.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout
+//   - coords: Geographic coordinates for the forecast location
+//
+// Returns:
+//   - *ports.ForecastData: One period per hour (typically 156 hours)
+//   - error: Returns error if coordinates are invalid, API is unavailable,
+//     or no forecast data is available
+func (c *Client) GetHourlyForecast(ctx context.Context, coords domain.Coordinates) (*ports.ForecastData, error) {
+	periods, freshness, err := c.fetchPeriods(ctx, coords, true)
+
+	if err != nil {
+		return nil, err
 	}
 
-	todayPeriod := forecast.Properties.Periods[0]
-	unit := domain.Fahrenheit
+	return &ports.ForecastData{Periods: toPeriodData(periods), Provider: ProviderName, Freshness: freshness}, nil
+}
+
+// LocationKey identifies the NWS forecast grid cell containing the coordinates.
+// Grid lookups are cached, so repeat calls for a location make no API request.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout
+//   - coords: Geographic coordinates to resolve
+//
+// Returns:
+//   - string: Grid cell key of the form "nws:OKX/33,35"
+//   - error: Points lookup error, or a response without grid coordinates
+func (c *Client) LocationKey(ctx context.Context, coords domain.Coordinates) (string, error) {
+	points, err := c.getPoints(ctx, coords)
 
-	if todayPeriod.TemperatureUnit == "C" {
-		unit = domain.Celsius
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve forecast grid: %w", err)
 	}
 
-	return &ports.WeatherData{
-		Temperature: float64(todayPeriod.Temperature),
-		Unit:        unit,
-		Forecast:    todayPeriod.ShortForecast,
-	}, nil
+	if points.Properties.GridID == "" {
+		return "", fmt.Errorf("failed to resolve forecast grid: no grid in response")
+	}
+
+	return gridKey(points), nil
 }
 
-// getForecastURL retrieves the forecast endpoint URL for the given coordinates.
+// CachedLocationKey identifies the NWS forecast grid cell containing the coordinates
+// from the grid cache alone, without calling the NWS API.
 //
 // Parameters:
-//   - ctx: Context for request cancellation
-//   - coords: Geographic coordinates to convert to NWS grid
+//   - ctx: Context for cancellation
+//   - coords: Geographic coordinates to resolve
 //
 // Returns:
-//   - string: URL for the forecast endpoint
-//   - error: HTTP error, non-200 status, or missing forecast URL
-func (c *Client) getForecastURL(ctx context.Context, coords domain.Coordinates) (string, error) {
-	url := fmt.Sprintf("%s/points/%.4f,%.4f", c.baseURL, coords.Latitude, coords.Longitude)
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+//   - string: Grid cell key of the form "nws:OKX/33,35"
+//   - bool: Whether the grid was cached
+func (c *Client) CachedLocationKey(ctx context.Context, coords domain.Coordinates) (string, bool) {
+	if c.grids == nil {
+		return "", false
+	}
+
+	points, ok := c.grids.lookup(ctx, coords)
+
+	if !ok || points.Properties.GridID == "" {
+		return "", false
+	}
+
+	return gridKey(points), true
+}
+
+// gridKey formats the location key of a resolved grid.
+//
+// Parameters:
+//   - points: Grid metadata returned by /points
+//
+// Returns:
+//   - string: Grid cell key of the form "nws:OKX/33,35"
+func gridKey(points *pointsResponse) string {
+	return fmt.Sprintf("%s:%s/%d,%d", ProviderName, points.Properties.GridID, points.Properties.GridX, points.Properties.GridY)
+}
+
+// fetchPeriods resolves the grid forecast URL for the coordinates and downloads its periods.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout
+//   - coords: Geographic coordinates for the forecast location
+//   - hourly: Whether to use the forecastHourly URL instead of the forecast URL
+//
+// Returns:
+//   - []forecastPeriod: Non-empty list of forecast periods
+//   - ports.Freshness: Freshness of the forecast response
+//   - error: Points lookup error, forecast fetch error, or empty forecast
+func (c *Client) fetchPeriods(ctx context.Context, coords domain.Coordinates, hourly bool) ([]forecastPeriod, ports.Freshness, error) {
+	points, err := c.getPoints(ctx, coords)
 
 	if err != nil {
-		return "", err
+		return nil, ports.Freshness{}, fmt.Errorf("failed to get forecast URL: %w", err)
 	}
 
-	req.Header.Set("User-Agent", "WeatherService/1.0")
-	
-	resp, err := c.httpClient.Do(req)
+	forecastURL := points.Properties.Forecast
+
+	if hourly {
+		forecastURL = points.Properties.ForecastHourly
+	}
+
+	if forecastURL == "" {
+		return nil, ports.Freshness{}, fmt.Errorf("failed to get forecast URL: no forecast URL in response")
+	}
+
+	forecast, freshness, err := c.fetchForecast(ctx, forecastURL)
 
 	if err != nil {
-		return "", err
+		return nil, ports.Freshness{}, fmt.Errorf("failed to fetch forecast: %w", err)
 	}
 
-	defer func(Body io.ReadCloser) {
-		_ = Body.Close()
-	}(resp.Body)
+	if len(forecast.Properties.Periods) == 0 {
+		return nil, ports.Freshness{}, fmt.Errorf("no forecast periods available")
+	}
 
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("NWS API returned status %d", resp.StatusCode)
+	return forecast.Properties.Periods, freshness, nil
+}
+
+// toPeriodData converts NWS forecast periods into provider-neutral period data.
+//
+// Parameters:
+//   - periods: NWS forecast periods
+//
+// Returns:
+//   - []ports.PeriodData: Converted periods in the same order
+func toPeriodData(periods []forecastPeriod) []ports.PeriodData {
+	result := make([]ports.PeriodData, 0, len(periods))
+
+	for _, p := range periods {
+		result = append(result, ports.PeriodData{
+			Name:                     p.Name,
+			StartTime:                p.StartTime,
+			EndTime:                  p.EndTime,
+			IsDaytime:                p.IsDaytime,
+			Temperature:              float64(p.Temperature),
+			Unit:                     parseTemperatureUnit(p.TemperatureUnit),
+			ShortForecast:            p.ShortForecast,
+			DetailedForecast:         p.DetailedForecast,
+			Wind:                     parseWind(p.WindSpeed, p.WindDirection),
+			PrecipitationProbability: p.ProbabilityOfPrecipitation.Value,
+			RelativeHumidity:         p.RelativeHumidity.Value,
+			Dewpoint:                 toTemperature(p.Dewpoint),
+			Icon:                     p.Icon,
+		})
+	}
+
+	return result
+}
+
+// parseTemperatureUnit converts an NWS temperature unit string into a domain unit.
+//
+// Parameters:
+//   - unit: NWS unit string ("F" or "C")
+//
+// Returns:
+//   - domain.TemperatureUnit: Celsius for "C", Fahrenheit otherwise
+func parseTemperatureUnit(unit string) domain.TemperatureUnit {
+	if unit == "C" {
+		return domain.Celsius
+	}
+
+	return domain.Fahrenheit
+}
+
+// parseWind converts NWS wind strings into a typed wind speed range.
+//
+// Parameters:
+//   - speed: NWS wind speed, e.g. "10 mph" or "10 to 15 mph"
+//   - direction: NWS compass direction, e.g. "NW"
+//
+// Returns:
+//   - *domain.Wind: Parsed wind, or nil if the speed is missing or unrecognized
+func parseWind(speed, direction string) *domain.Wind {
+	match := windSpeedPattern.FindStringSubmatch(strings.TrimSpace(speed))
+
+	if match == nil {
+		return nil
+	}
+
+	unit := domain.MilesPerHour
+
+	if match[3] == "km/h" {
+		unit = domain.KilometersPerHour
+	}
+
+	minSpeed, _ := strconv.ParseFloat(match[1], 64)
+	maxSpeed := minSpeed
+
+	if match[2] != "" {
+		maxSpeed, _ = strconv.ParseFloat(match[2], 64)
+	}
+
+	return &domain.Wind{
+		MinSpeed:  domain.Speed{Value: minSpeed, Unit: unit},
+		MaxSpeed:  domain.Speed{Value: maxSpeed, Unit: unit},
+		Direction: direction,
 	}
+}
+
+// getPoints resolves the NWS grid metadata, including forecast URLs, for the given coordinates.
+// Resolved grids are served from the grid cache when one is configured.
+//
+// Parameters:
+//   - ctx: Context for request cancellation
+//   - coords: Geographic coordinates to convert to NWS grid
+//
+// Returns:
+//   - *pointsResponse: Grid metadata with forecast endpoint URLs
+//   - error: Wraps domain.ErrLocationNotSupported if NWS has no grid for the
+//     point (outside US coverage); otherwise HTTP error, non-200 status, or JSON decode error
+func (c *Client) getPoints(ctx context.Context, coords domain.Coordinates) (*pointsResponse, error) {
+	if c.grids != nil {
+		if points, ok := c.grids.get(ctx, coords); ok {
+			return points, nil
+		}
+	}
+
+	url := fmt.Sprintf("%s/points/%.4f,%.4f", c.pointsBaseURL, coords.Latitude, coords.Longitude)
 
 	var points pointsResponse
 
-	if err := json.NewDecoder(resp.Body).Decode(&points); err != nil {
-		return "", err
+	if _, err := c.getJSON(ctx, url, nil, &points); err != nil {
+		var statusErr *statusError
+
+		if errors.As(err, &statusErr) && statusErr.statusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: NWS has no forecast grid for %.4f,%.4f",
+				domain.ErrLocationNotSupported, coords.Latitude, coords.Longitude)
+		}
+
+		return nil, err
 	}
 
-	if points.Properties.Forecast == "" {
-		return "", fmt.Errorf("no forecast URL in response")
+	if c.grids != nil {
+		c.grids.set(ctx, coords, &points)
 	}
 
-	return points.Properties.Forecast, nil
+	return &points, nil
 }
 
 // fetchForecast retrieves the actual forecast data from the NWS forecast endpoint.
+// When the context carries the validators of a cached copy (see ports.WithValidators),
+// the request is conditional, and a 304 response is returned as a
+// *ports.NotModifiedError with the freshness of the new response.
 //
 // Parameters:
 //   - ctx: Context for cancellation (auto-adds 10s timeout of none)
@@ -166,21 +538,146 @@ func (c *Client) getForecastURL(ctx context.Context, coords domain.Coordinates)
 //
 // Returns:
 //   - *forecastResponse: Parsed forecast data with periods
-//   - error: HTTP error, non-200 status, or JSON decode error
-func (c *Client) fetchForecast(ctx context.Context, forecastURL string) (*forecastResponse, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", forecastURL, nil)
+//   - ports.Freshness: Caching headers and validators of the response and the forecast's
+//     generation and update times
+//   - error: *ports.NotModifiedError for a 304, otherwise HTTP error, non-200 status, or
+//     JSON decode error
+func (c *Client) fetchForecast(ctx context.Context, forecastURL string) (*forecastResponse, ports.Freshness, error) {
+	validators, revalidate := ports.ValidatorsFromContext(ctx)
 
-	if err != nil {
-		return nil, err
+	var conditional http.Header
+
+	if revalidate {
+		conditional = conditionalHeader(validators)
+	}
+
+	var forecast forecastResponse
+
+	header, err := c.getJSON(ctx, forecastURL, conditional, &forecast)
+	notModified := errors.Is(err, errNotModified)
+
+	if revalidate && (err == nil || notModified) {
+		c.recordConditional(ctx, notModified)
+	}
+
+	if err != nil && !notModified {
+		return nil, ports.Freshness{}, err
 	}
 
-	req.Header.Set("User-Agent", "WeatherService/1.0")
+	freshness := parseFreshness(header, time.Now())
+	freshness.ETag = header.Get("ETag")
 
+	if notModified {
+		// A 304 need not repeat every validator of the response it confirms
+		freshness.ETag = cmp.Or(freshness.ETag, validators.ETag)
+
+		if freshness.LastModified.IsZero() {
+			freshness.LastModified = validators.LastModified
+		}
+
+		return nil, ports.Freshness{}, &ports.NotModifiedError{Freshness: freshness}
+	}
+
+	freshness.GeneratedAt = forecast.Properties.GeneratedAt
+	freshness.UpdatedAt = forecast.Properties.UpdateTime
+
+	return &forecast, freshness, nil
+}
+
+// getJSON performs a GET request against an NWS endpoint and decodes the JSON body.
+// Failed attempts are retried according to the client's retry policy, each retry
+// recorded as an event on the current span. A retry that could not start before the
+// context deadline is not attempted, so retries never extend a caller's deadline.
+//
+// Parameters:
+//   - ctx: Context for cancellation (auto-adds 10s timeout if none, covering every attempt)
+//   - url: Fully qualified NWS endpoint URL
+//   - conditional: Conditional request headers such as If-None-Match (can be nil)
+//   - dest: Pointer to the value the response body is decoded into
+//
+// Returns:
+//   - http.Header: Headers of the successful or 304 response
+//   - error: errNotModified for a 304, otherwise error of the last attempt: HTTP error,
+//     non-200 status, or JSON decode error
+func (c *Client) getJSON(ctx context.Context, url string, conditional http.Header, dest interface{}) (http.Header, error) {
 	if _, hasDeadline := ctx.Deadline(); !hasDeadline {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, 10*time.Second)
 		defer cancel()
-		req = req.WithContext(ctx)
+	}
+
+	span := trace.SpanFromContext(ctx)
+
+	for attempt := 1; ; attempt++ {
+		header, err := c.get(ctx, url, conditional, dest)
+
+		if err == nil || errors.Is(err, errNotModified) || ctx.Err() != nil {
+			return header, err
+		}
+
+		delay, reason, retry := c.retry.next(ctx, attempt, err)
+
+		if !retry {
+			return nil, err
+		}
+
+		if deadline, _ := ctx.Deadline(); time.Until(deadline) <= delay {
+			c.logger.Debug("NWS request not retried before deadline", zap.String("url", url), zap.Error(err))
+			return nil, err
+		}
+
+		span.AddEvent("nws.retry", trace.WithAttributes(
+			attribute.Int("retry.attempt", attempt+1),
+			attribute.String("retry.reason", reason),
+			attribute.Int64("retry.delay_ms", delay.Milliseconds()),
+			attribute.String("retry.error", err.Error()),
+			attribute.String("http.url", url),
+		))
+		c.logger.Debug("retrying NWS request",
+			zap.String("url", url),
+			zap.Int("attempt", attempt+1),
+			zap.Duration("delay", delay),
+			zap.Error(err),
+		)
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		case <-timer.C:
+		}
+	}
+}
+
+// get makes a single GET request against an NWS endpoint and decodes the JSON body.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - url: Fully qualified NWS endpoint URL
+//   - conditional: Conditional request headers such as If-None-Match (can be nil)
+//   - dest: Pointer to the value the response body is decoded into
+//
+// Returns:
+//   - http.Header: Response headers
+//   - error: errNotModified for a 304, otherwise HTTP error, non-200 status, or JSON decode error
+func (c *Client) get(ctx context.Context, url string, conditional http.Header, dest interface{}) (http.Header, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for name, values := range conditional {
+		req.Header[name] = values
+	}
+
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("Accept", c.accept)
+
+	if c.featureFlags != "" {
+		req.Header.Set("Feature-Flags", c.featureFlags)
 	}
 
 	resp, err := c.httpClient.Do(req)
@@ -197,15 +694,45 @@ func (c *Client) fetchForecast(ctx context.Context, forecastURL string) (*foreca
 		}
 	}(resp.Body)
 
+	if resp.StatusCode == http.StatusNotModified && conditional != nil {
+		return resp.Header, errNotModified
+	}
+
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("NWS API returned status %d", resp.StatusCode)
+		return nil, &statusError{
+			statusCode: resp.StatusCode,
+			retryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		}
 	}
 
-	var forecast forecastResponse
+	return resp.Header, c.decode(resp.Body, dest)
+}
 
-	if err := json.NewDecoder(resp.Body).Decode(&forecast); err != nil {
-		return nil, err
+// decode decodes an NWS response body in the client's media type. JSON-LD bodies are
+// rewritten as GeoJSON first, so every response type has a single decoder.
+//
+// Parameters:
+//   - body: Response body
+//   - dest: Pointer to the value the body is decoded into
+//
+// Returns:
+//   - error: Read or JSON decode error
+func (c *Client) decode(body io.Reader, dest interface{}) error {
+	if c.accept != MediaTypeJSONLD {
+		return json.NewDecoder(body).Decode(dest)
+	}
+
+	data, err := io.ReadAll(body)
+
+	if err != nil {
+		return err
+	}
+
+	data, err = geoJSONFromLD(data)
+
+	if err != nil {
+		return err
 	}
 
-	return &forecast, nil
+	return json.Unmarshal(data, dest)
 }
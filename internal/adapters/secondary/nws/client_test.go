@@ -0,0 +1,549 @@
+// Package nws contains unit tests for the NWS API client.
+package nws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+)
+
+// newTestServer starts an httptest server that serves the given routes.
+// Any route not present in the map returns 404.
+//
+// Parameters:
+//   - t: Test instance used to register server cleanup
+//   - routes: Map of request path to handler
+//
+// Returns:
+//   - *httptest.Server: Running test server
+func newTestServer(t *testing.T, routes map[string]http.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if handler, ok := routes[r.URL.Path]; ok {
+			handler(w, r)
+			return
+		}
+
+		http.NotFound(w, r)
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// TestClient_GetObservation tests station selection and fallback for observations.
+func TestClient_GetObservation(t *testing.T) {
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	recent := time.Now().Add(-30 * time.Minute).UTC().Format(time.RFC3339)
+	stale := time.Now().Add(-6 * time.Hour).UTC().Format(time.RFC3339)
+
+	observation := func(timestamp string, temperature string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintf(w, `{"properties":{
+				"timestamp":%q,
+				"textDescription":"Cloudy",
+				"temperature":{"unitCode":"wmoUnit:degC","value":%s},
+				"dewpoint":{"unitCode":"wmoUnit:degC","value":12.1},
+				"relativeHumidity":{"unitCode":"wmoUnit:percent","value":55.3},
+				"windSpeed":{"unitCode":"wmoUnit:km_h-1","value":18.36},
+				"windDirection":{"unitCode":"wmoUnit:degree_(angle)","value":230},
+				"barometricPressure":{"unitCode":"wmoUnit:Pa","value":101590},
+				"visibility":{"unitCode":"wmoUnit:m","value":null}
+			}}`, timestamp, temperature)
+		}
+	}
+
+	tests := []struct {
+		name            string
+		routes          map[string]http.HandlerFunc
+		expectedError   bool
+		expectedStation string
+	}{
+		{
+			name: "nearest station has recent data",
+			routes: map[string]http.HandlerFunc{
+				"/stations/KNYC/observations/latest": observation(recent, "21.7"),
+			},
+			expectedStation: "KNYC",
+		},
+		{
+			name: "falls back when nearest station is stale",
+			routes: map[string]http.HandlerFunc{
+				"/stations/KNYC/observations/latest": observation(stale, "21.7"),
+				"/stations/KLGA/observations/latest": observation(recent, "22.2"),
+			},
+			expectedStation: "KLGA",
+		},
+		{
+			name: "falls back when nearest station reports no temperature",
+			routes: map[string]http.HandlerFunc{
+				"/stations/KNYC/observations/latest": observation(recent, "null"),
+				"/stations/KLGA/observations/latest": observation(recent, "22.2"),
+			},
+			expectedStation: "KLGA",
+		},
+		{
+			name: "no station has recent data",
+			routes: map[string]http.HandlerFunc{
+				"/stations/KNYC/observations/latest": observation(stale, "21.7"),
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var baseURL string
+
+			routes := map[string]http.HandlerFunc{
+				"/points/40.7128,-74.0060": func(w http.ResponseWriter, r *http.Request) {
+					_, _ = fmt.Fprintf(w, `{"properties":{"observationStations":"%s/gridpoints/OKX/33,35/stations"}}`, baseURL)
+				},
+				"/gridpoints/OKX/33,35/stations": func(w http.ResponseWriter, r *http.Request) {
+					_, _ = fmt.Fprint(w, `{"features":[
+						{"geometry":{"coordinates":[-73.96925,40.77898]},"properties":{"stationIdentifier":"KNYC","name":"New York City, Central Park"}},
+						{"geometry":{"coordinates":[-73.88,40.77945]},"properties":{"stationIdentifier":"KLGA","name":"New York, La Guardia Airport"}}
+					]}`)
+				},
+			}
+
+			server := newTestServer(t, routes)
+			baseURL = server.URL
+
+			for path, handler := range tt.routes {
+				routes[path] = handler
+			}
+
+			client := NewClient(server.URL, server.Client(), nil, Config{}, zap.NewNop())
+			data, err := client.GetObservation(context.Background(), coords)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, data)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStation, data.StationID)
+			assert.Equal(t, domain.Celsius, data.Temperature.Unit)
+			assert.Equal(t, domain.Kilometers, data.StationDistance.Unit)
+			assert.Greater(t, data.StationDistance.Value, 0.0)
+			assert.Equal(t, &domain.Pressure{Value: 101590, Unit: domain.Pascal}, data.Pressure)
+			assert.Equal(t, &domain.Speed{Value: 18.36, Unit: domain.KilometersPerHour}, data.WindSpeed)
+			assert.Nil(t, data.Visibility)
+		})
+	}
+}
+
+// TestClient_GetAlerts tests decoding of active alerts for a point.
+func TestClient_GetAlerts(t *testing.T) {
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+
+	t.Run("decodes active alerts", func(t *testing.T) {
+		server := newTestServer(t, map[string]http.HandlerFunc{
+			"/alerts/active": func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "40.7128,-74.0060", r.URL.Query().Get("point"))
+
+				_, _ = fmt.Fprint(w, `{"features":[{"properties":{
+					"id":"urn:oid:2.49.0.1.840.0.1",
+					"areaDesc":"New York (Manhattan)",
+					"affectedZones":["https://api.weather.gov/zones/forecast/NYZ072"],
+					"onset":null,
+					"expires":"2024-07-16T20:00:00-04:00",
+					"severity":"Moderate",
+					"certainty":"Likely",
+					"urgency":"Expected",
+					"event":"Heat Advisory",
+					"headline":"Heat Advisory issued for New York County",
+					"description":"Heat index values up to 105 expected.",
+					"instruction":null
+				}}]}`)
+			},
+		})
+
+		client := NewClient(server.URL, server.Client(), nil, Config{}, zap.NewNop())
+		alerts, err := client.GetAlerts(context.Background(), coords)
+
+		assert.NoError(t, err)
+		assert.Len(t, alerts, 1)
+		assert.Equal(t, "Heat Advisory", alerts[0].Event)
+		assert.Equal(t, domain.SeverityModerate, alerts[0].Severity)
+		assert.Equal(t, domain.UrgencyExpected, alerts[0].Urgency)
+		assert.Equal(t, domain.CertaintyLikely, alerts[0].Certainty)
+		assert.Equal(t, []string{"https://api.weather.gov/zones/forecast/NYZ072"}, alerts[0].AffectedZones)
+		assert.True(t, alerts[0].Onset.IsZero())
+		assert.Equal(t, 2024, alerts[0].Expires.Year())
+	})
+
+	t.Run("no active alerts", func(t *testing.T) {
+		server := newTestServer(t, map[string]http.HandlerFunc{
+			"/alerts/active": func(w http.ResponseWriter, r *http.Request) {
+				_, _ = fmt.Fprint(w, `{"features":[]}`)
+			},
+		})
+
+		client := NewClient(server.URL, server.Client(), nil, Config{}, zap.NewNop())
+		alerts, err := client.GetAlerts(context.Background(), coords)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, alerts)
+		assert.Empty(t, alerts)
+	})
+}
+
+// TestClient_GetForecast tests decoding of the first forecast period with its details.
+func TestClient_GetForecast(t *testing.T) {
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+
+	var baseURL string
+
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"/points/40.7128,-74.0060": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintf(w, `{"properties":{"forecast":"%s/gridpoints/OKX/33,35/forecast"}}`, baseURL)
+		},
+		"/gridpoints/OKX/33,35/forecast": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, `{"properties":{"periods":[{
+				"name":"Today",
+				"isDaytime":true,
+				"temperature":84,
+				"temperatureUnit":"F",
+				"windSpeed":"10 to 15 mph",
+				"windDirection":"SW",
+				"probabilityOfPrecipitation":{"unitCode":"wmoUnit:percent","value":40},
+				"relativeHumidity":{"unitCode":"wmoUnit:percent","value":68},
+				"dewpoint":{"unitCode":"wmoUnit:degC","value":20.5},
+				"icon":"https://api.weather.gov/icons/land/day/tsra,40?size=medium",
+				"shortForecast":"Chance Showers And Thunderstorms",
+				"detailedForecast":"A chance of showers and thunderstorms after 2pm."
+			}]}}`)
+		},
+	})
+	baseURL = server.URL
+
+	client := NewClient(server.URL, server.Client(), nil, Config{}, zap.NewNop())
+	data, err := client.GetForecast(context.Background(), coords)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 84.0, data.Temperature)
+	assert.Equal(t, "Chance Showers And Thunderstorms", data.Forecast)
+	assert.Equal(t, "A chance of showers and thunderstorms after 2pm.", data.DetailedForecast)
+	assert.Equal(t, &domain.Wind{
+		MinSpeed:  domain.Speed{Value: 10, Unit: domain.MilesPerHour},
+		MaxSpeed:  domain.Speed{Value: 15, Unit: domain.MilesPerHour},
+		Direction: "SW",
+	}, data.Wind)
+	assert.Equal(t, 40.0, *data.PrecipitationProbability)
+	assert.Equal(t, 68.0, *data.RelativeHumidity)
+	assert.Equal(t, &domain.Temperature{Value: 20.5, Unit: domain.Celsius}, data.Dewpoint)
+	assert.Equal(t, "https://api.weather.gov/icons/land/day/tsra,40?size=medium", data.Icon)
+	assert.Equal(t, ProviderName, data.Provider)
+}
+
+// TestParseWind tests parsing of NWS wind speed strings.
+func TestParseWind(t *testing.T) {
+	tests := []struct {
+		name     string
+		speed    string
+		expected *domain.Wind
+	}{
+		{
+			name:  "range",
+			speed: "10 to 15 mph",
+			expected: &domain.Wind{
+				MinSpeed:  domain.Speed{Value: 10, Unit: domain.MilesPerHour},
+				MaxSpeed:  domain.Speed{Value: 15, Unit: domain.MilesPerHour},
+				Direction: "NW",
+			},
+		},
+		{
+			name:  "single value",
+			speed: "5 mph",
+			expected: &domain.Wind{
+				MinSpeed:  domain.Speed{Value: 5, Unit: domain.MilesPerHour},
+				MaxSpeed:  domain.Speed{Value: 5, Unit: domain.MilesPerHour},
+				Direction: "NW",
+			},
+		},
+		{
+			name:  "metric",
+			speed: "20 to 30 km/h",
+			expected: &domain.Wind{
+				MinSpeed:  domain.Speed{Value: 20, Unit: domain.KilometersPerHour},
+				MaxSpeed:  domain.Speed{Value: 30, Unit: domain.KilometersPerHour},
+				Direction: "NW",
+			},
+		},
+		{
+			name:  "empty",
+			speed: "",
+		},
+		{
+			name:  "unrecognized",
+			speed: "calm",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseWind(tt.speed, "NW"))
+		})
+	}
+}
+
+// TestClient_OutsideCoverage tests that points NWS has no grid for are reported
+// as unsupported rather than as upstream failures.
+func TestClient_OutsideCoverage(t *testing.T) {
+	paris := domain.Coordinates{Latitude: 48.8566, Longitude: 2.3522}
+
+	t.Run("points 404 is unsupported", func(t *testing.T) {
+		server := newTestServer(t, map[string]http.HandlerFunc{})
+		client := NewClient(server.URL, server.Client(), nil, Config{}, zap.NewNop())
+
+		_, err := client.GetForecast(context.Background(), paris)
+		assert.ErrorIs(t, err, domain.ErrLocationNotSupported)
+
+		_, err = client.GetHourlyForecast(context.Background(), paris)
+		assert.ErrorIs(t, err, domain.ErrLocationNotSupported)
+
+		_, err = client.GetObservation(context.Background(), paris)
+		assert.ErrorIs(t, err, domain.ErrLocationNotSupported)
+	})
+
+	t.Run("points 500 is a failure", func(t *testing.T) {
+		server := newTestServer(t, map[string]http.HandlerFunc{
+			"/points/48.8566,2.3522": func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+		})
+
+		_, err := NewClient(server.URL, server.Client(), nil, Config{}, zap.NewNop()).GetForecast(context.Background(), paris)
+
+		assert.ErrorContains(t, err, "status 500")
+		assert.NotErrorIs(t, err, domain.ErrLocationNotSupported)
+	})
+}
+
+// mapCache is an in-memory CacheService that records the TTL of each entry.
+type mapCache struct {
+	entries map[string][]byte
+	ttls    map[string]time.Duration
+}
+
+// newMapCache creates an empty mapCache.
+func newMapCache() *mapCache {
+	return &mapCache{entries: map[string][]byte{}, ttls: map[string]time.Duration{}}
+}
+
+// Get returns the cached value or an error if the key is absent.
+func (m *mapCache) Get(_ context.Context, key string) ([]byte, error) {
+	if value, ok := m.entries[key]; ok {
+		return value, nil
+	}
+
+	return nil, errors.New("cache miss")
+}
+
+// Set stores the value and its TTL.
+func (m *mapCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	m.entries[key] = value
+	m.ttls[key] = ttl
+
+	return nil
+}
+
+// Delete removes the key.
+func (m *mapCache) Delete(_ context.Context, key string) error {
+	delete(m.entries, key)
+	delete(m.ttls, key)
+
+	return nil
+}
+
+// Clear removes every key.
+func (m *mapCache) Clear(context.Context) error {
+	m.entries = map[string][]byte{}
+	m.ttls = map[string]time.Duration{}
+
+	return nil
+}
+
+// DeletePattern is not used by the client.
+func (m *mapCache) DeletePattern(context.Context, string) (int64, error) {
+	return 0, nil
+}
+
+// TestClient_GridCache tests that /points lookups are cached across forecast,
+// hourly and observation requests and that hits and misses are counted.
+func TestClient_GridCache(t *testing.T) {
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	pointsCalls := 0
+
+	var baseURL string
+
+	periods := func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"properties":{"periods":[{"name":"Today","temperature":75,"temperatureUnit":"F","shortForecast":"Sunny"}]}}`)
+	}
+
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"/points/40.7128,-74.0060": func(w http.ResponseWriter, r *http.Request) {
+			pointsCalls++
+
+			_, _ = fmt.Fprintf(w, `{"properties":{
+				"gridId":"OKX","gridX":33,"gridY":35,
+				"forecast":"%[1]s/gridpoints/OKX/33,35/forecast",
+				"forecastHourly":"%[1]s/gridpoints/OKX/33,35/forecast/hourly",
+				"observationStations":"%[1]s/gridpoints/OKX/33,35/stations"
+			}}`, baseURL)
+		},
+		"/gridpoints/OKX/33,35/forecast":        periods,
+		"/gridpoints/OKX/33,35/forecast/hourly": periods,
+		"/gridpoints/OKX/33,35/stations": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, `{"features":[{"geometry":{"coordinates":[-73.96925,40.77898]},"properties":{"stationIdentifier":"KNYC","name":"New York City, Central Park"}}]}`)
+		},
+		"/stations/KNYC/observations/latest": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintf(w, `{"properties":{"timestamp":%q,"temperature":{"unitCode":"wmoUnit:degC","value":21.7}}}`,
+				time.Now().UTC().Format(time.RFC3339))
+		},
+	})
+	baseURL = server.URL
+
+	cache := newMapCache()
+	reader := sdkmetric.NewManualReader()
+	meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+	client := NewClient(server.URL, server.Client(), cache, Config{GridCacheTTL: 48 * time.Hour, Meter: meter}, zap.NewNop())
+
+	_, err := client.GetForecast(context.Background(), coords)
+	assert.NoError(t, err)
+
+	_, err = client.GetHourlyForecast(context.Background(), coords)
+	assert.NoError(t, err)
+
+	_, err = client.GetObservation(context.Background(), coords)
+	assert.NoError(t, err)
+
+	key, err := client.LocationKey(context.Background(), coords)
+	assert.NoError(t, err)
+	assert.Equal(t, "nws:OKX/33,35", key)
+
+	assert.Equal(t, 1, pointsCalls, "the grid is resolved once per location")
+	assert.Equal(t, 48*time.Hour, cache.ttls["nws:points:40.7128,-74.0060"])
+
+	var metrics metricdata.ResourceMetrics
+
+	assert.NoError(t, reader.Collect(context.Background(), &metrics))
+
+	counts := map[string]int64{}
+
+	for _, scope := range metrics.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if sum, ok := m.Data.(metricdata.Sum[int64]); ok {
+				for _, point := range sum.DataPoints {
+					counts[m.Name] += point.Value
+				}
+			}
+		}
+	}
+
+	assert.Equal(t, int64(3), counts["nws_grid_cache_hits_total"])
+	assert.Equal(t, int64(1), counts["nws_grid_cache_misses_total"])
+
+	key, ok := client.CachedLocationKey(context.Background(), coords)
+	assert.True(t, ok)
+	assert.Equal(t, "nws:OKX/33,35", key)
+
+	_, ok = client.CachedLocationKey(context.Background(), domain.Coordinates{Latitude: 41.8781, Longitude: -87.6298})
+	assert.False(t, ok, "uncached grids are not resolved")
+	assert.Equal(t, 1, pointsCalls, "cached keys never call /points")
+
+	t.Run("unsupported locations are not cached", func(t *testing.T) {
+		paris := domain.Coordinates{Latitude: 48.8566, Longitude: 2.3522}
+
+		_, err := client.GetForecast(context.Background(), paris)
+
+		assert.ErrorIs(t, err, domain.ErrLocationNotSupported)
+		assert.NotContains(t, cache.entries, "nws:points:48.8566,2.3522")
+	})
+}
+
+// TestClient_RequestConfig tests the configured request headers and per-endpoint base URLs.
+func TestClient_RequestConfig(t *testing.T) {
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	recent := time.Now().Add(-30 * time.Minute).UTC().Format(time.RFC3339)
+
+	var headers []http.Header
+
+	record := func(body func() string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			headers = append(headers, r.Header.Clone())
+			_, _ = fmt.Fprint(w, body())
+		}
+	}
+
+	var mirrorURL string
+	mirror := newTestServer(t, map[string]http.HandlerFunc{
+		"/points/40.7128,-74.0060": record(func() string {
+			return fmt.Sprintf(`{"properties":{"gridId":"OKX","gridX":33,"gridY":35,"observationStations":"%s/gridpoints/OKX/33,35/stations"}}`, mirrorURL)
+		}),
+		"/gridpoints/OKX/33,35/stations": record(func() string {
+			return `{"features":[{"geometry":{"coordinates":[-73.96925,40.77898]},"properties":{"stationIdentifier":"KNYC","name":"Central Park"}}]}`
+		}),
+		"/stations/KNYC/observations/latest": record(func() string {
+			return fmt.Sprintf(`{"properties":{"timestamp":%q,"temperature":{"unitCode":"wmoUnit:degC","value":21.7}}}`, recent)
+		}),
+		"/alerts/active": record(func() string { return `{"features":[]}` }),
+	})
+	mirrorURL = mirror.URL
+
+	t.Run("headers and endpoint overrides", func(t *testing.T) {
+		headers = nil
+
+		client := NewClient("http://127.0.0.1:1", mirror.Client(), nil, Config{
+			UserAgent:       "acme-weather/2.3",
+			Contact:         "ops@example.com",
+			Accept:          MediaTypeGeoJSON,
+			FeatureFlags:    []string{"forecast_temperature_qv", "forecast_wind_speed_qv"},
+			PointsBaseURL:   mirror.URL,
+			AlertsBaseURL:   mirror.URL,
+			StationsBaseURL: mirror.URL,
+		}, zap.NewNop())
+
+		_, err := client.GetObservation(context.Background(), coords)
+		assert.NoError(t, err)
+
+		_, err = client.GetAlerts(context.Background(), coords)
+		assert.NoError(t, err)
+
+		assert.Len(t, headers, 4, "every endpoint is served by its overridden base URL")
+
+		for _, header := range headers {
+			assert.Equal(t, "acme-weather/2.3 (ops@example.com)", header.Get("User-Agent"))
+			assert.Equal(t, "application/geo+json", header.Get("Accept"))
+			assert.Equal(t, "forecast_temperature_qv,forecast_wind_speed_qv", header.Get("Feature-Flags"))
+		}
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		headers = nil
+
+		_, err := NewClient(mirror.URL, mirror.Client(), nil, Config{Accept: "text/xml"}, zap.NewNop()).GetAlerts(context.Background(), coords)
+
+		assert.NoError(t, err)
+		assert.Equal(t, DefaultUserAgent, headers[0].Get("User-Agent"))
+		assert.Equal(t, MediaTypeGeoJSON, headers[0].Get("Accept"), "unknown media types fall back to GeoJSON")
+		assert.Empty(t, headers[0].Values("Feature-Flags"))
+	})
+}
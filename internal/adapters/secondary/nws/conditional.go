@@ -0,0 +1,80 @@
+package nws
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// errNotModified is returned for a 304 response to a conditional request.
+var errNotModified = errors.New("NWS API returned status 304")
+
+// newConditionalCounter creates the counter of conditional forecast requests on the given meter.
+//
+// Parameters:
+//   - meter: Meter for the counter; nil uses the global meter provider
+//   - logger: Zap logger for instrument creation failures
+//
+// Returns:
+//   - metric.Int64Counter: Counter of conditional requests by result
+func newConditionalCounter(meter metric.Meter, logger *zap.Logger) metric.Int64Counter {
+	if meter == nil {
+		meter = otel.Meter(instrumentationName)
+	}
+
+	requests, err := meter.Int64Counter(
+		"nws_conditional_requests_total",
+		metric.WithDescription("Total number of conditional NWS forecast requests by result (not_modified or modified)"),
+		metric.WithUnit("1"),
+	)
+
+	if err != nil {
+		logger.Warn("failed to create conditional request counter", zap.Error(err))
+	}
+
+	return requests
+}
+
+// recordConditional counts a conditional request by whether NWS answered 304.
+//
+// Parameters:
+//   - ctx: Context for the measurement
+//   - notModified: Whether the forecast was unchanged
+func (c *Client) recordConditional(ctx context.Context, notModified bool) {
+	result := "modified"
+
+	if notModified {
+		result = "not_modified"
+	}
+
+	c.conditionals.Add(ctx, 1, metric.WithAttributes(attribute.String("result", result)))
+}
+
+// conditionalHeader builds the request headers that ask NWS to answer 304 if the
+// caller's cached forecast is still current.
+//
+// Parameters:
+//   - validators: ETag and Last-Modified of the cached forecast
+//
+// Returns:
+//   - http.Header: If-None-Match and If-Modified-Since for the validators
+func conditionalHeader(validators ports.Freshness) http.Header {
+	header := http.Header{}
+
+	if validators.ETag != "" {
+		header.Set("If-None-Match", validators.ETag)
+	}
+
+	if !validators.LastModified.IsZero() {
+		header.Set("If-Modified-Since", validators.LastModified.UTC().Format(http.TimeFormat))
+	}
+
+	return header
+}
@@ -0,0 +1,105 @@
+package nws
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// TestClient_ConditionalRequests tests that forecasts are revalidated with the ETag and
+// Last-Modified validators carried by the context and that 304 responses are reported
+// as not modified.
+func TestClient_ConditionalRequests(t *testing.T) {
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	etag, temperature := `"v1"`, 75
+	lastModified := "Mon, 15 Jan 2024 11:00:00 GMT"
+
+	var ifNoneMatch, ifModifiedSince []string
+
+	var serverURL string
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"/points/40.7128,-74.0060": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(fmt.Sprintf(pointsBody, serverURL)))
+		},
+		"/gridpoints/OKX/33,35/forecast": func(w http.ResponseWriter, r *http.Request) {
+			ifNoneMatch = append(ifNoneMatch, r.Header.Get("If-None-Match"))
+			ifModifiedSince = append(ifModifiedSince, r.Header.Get("If-Modified-Since"))
+
+			w.Header().Set("Cache-Control", "public, max-age=600")
+
+			if r.Header.Get("If-None-Match") == etag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+
+			w.Header().Set("ETag", etag)
+			w.Header().Set("Last-Modified", lastModified)
+			_, _ = fmt.Fprintf(w, `{"properties":{"periods":[{"name":"Today","temperature":%d,"temperatureUnit":"F","shortForecast":"Sunny"}]}}`, temperature)
+		},
+	})
+	serverURL = server.URL
+
+	reader := sdkmetric.NewManualReader()
+	meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+	client := NewClient(server.URL, server.Client(), nil, Config{Meter: meter}, zap.NewNop())
+
+	data, err := client.GetForecast(context.Background(), coords)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 75.0, data.Temperature)
+	assert.Equal(t, `"v1"`, data.Freshness.ETag)
+	assert.True(t, time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC).Equal(data.Freshness.LastModified))
+
+	validators := data.Freshness
+
+	start := time.Now()
+	_, err = client.GetForecast(ports.WithValidators(context.Background(), validators), coords)
+
+	var notModified *ports.NotModifiedError
+
+	if assert.ErrorAs(t, err, &notModified, "a 304 is reported as not modified") {
+		assert.Equal(t, `"v1"`, notModified.Freshness.ETag)
+		assert.True(t, validators.LastModified.Equal(notModified.Freshness.LastModified))
+		assert.WithinDuration(t, start.Add(10*time.Minute), notModified.Freshness.Expires, 5*time.Second)
+	}
+
+	etag, temperature = `"v2"`, 80
+	data, err = client.GetForecast(ports.WithValidators(context.Background(), validators), coords)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 80.0, data.Temperature, "a changed forecast is downloaded")
+	assert.Equal(t, `"v2"`, data.Freshness.ETag)
+
+	assert.Equal(t, []string{"", `"v1"`, `"v1"`}, ifNoneMatch)
+	assert.Equal(t, []string{"", lastModified, lastModified}, ifModifiedSince)
+
+	var metrics metricdata.ResourceMetrics
+
+	assert.NoError(t, reader.Collect(context.Background(), &metrics))
+
+	results := map[string]int64{}
+
+	for _, scope := range metrics.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if sum, ok := m.Data.(metricdata.Sum[int64]); ok && m.Name == "nws_conditional_requests_total" {
+				for _, point := range sum.DataPoints {
+					result, _ := point.Attributes.Value(attribute.Key("result"))
+					results[result.AsString()] += point.Value
+				}
+			}
+		}
+	}
+
+	assert.Equal(t, map[string]int64{"not_modified": 1, "modified": 1}, results, "only conditional requests are counted")
+}
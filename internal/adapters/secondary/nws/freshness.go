@@ -0,0 +1,86 @@
+package nws
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// parseFreshness reads the caching headers of an NWS response. The lifetime comes
+// from Cache-Control s-maxage or max-age less the Age header, or failing those from
+// Expires relative to the Date header, so that clock skew between NWS and this host
+// does not shorten or stretch it. no-cache and no-store make the response expire at once.
+//
+// Parameters:
+//   - header: Response headers
+//   - now: Time the response was received
+//
+// Returns:
+//   - ports.Freshness: Expires and LastModified, zero where the headers are missing or malformed
+func parseFreshness(header http.Header, now time.Time) ports.Freshness {
+	var freshness ports.Freshness
+
+	if lastModified, err := http.ParseTime(header.Get("Last-Modified")); err == nil {
+		freshness.LastModified = lastModified
+	}
+
+	if lifetime, ok := cacheLifetime(header); ok {
+		age, _ := strconv.Atoi(strings.TrimSpace(header.Get("Age")))
+		freshness.Expires = now.Add(lifetime - time.Duration(max(age, 0))*time.Second)
+
+		return freshness
+	}
+
+	if expires, err := http.ParseTime(header.Get("Expires")); err == nil {
+		if date, err := http.ParseTime(header.Get("Date")); err == nil {
+			freshness.Expires = now.Add(expires.Sub(date))
+		} else {
+			freshness.Expires = expires
+		}
+	}
+
+	return freshness
+}
+
+// cacheLifetime reads the freshness lifetime from a Cache-Control header. s-maxage
+// takes precedence over max-age, since this service is a shared cache.
+//
+// Parameters:
+//   - header: Response headers
+//
+// Returns:
+//   - time.Duration: Freshness lifetime; zero for no-cache and no-store
+//   - bool: Whether Cache-Control set a lifetime
+func cacheLifetime(header http.Header) (time.Duration, bool) {
+	maxAge, sharedMaxAge := -1, -1
+
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		seconds, err := strconv.Atoi(strings.Trim(value, `"`))
+
+		switch strings.ToLower(name) {
+		case "no-cache", "no-store":
+			return 0, true
+		case "max-age":
+			if err == nil {
+				maxAge = seconds
+			}
+		case "s-maxage":
+			if err == nil {
+				sharedMaxAge = seconds
+			}
+		}
+	}
+
+	switch {
+	case sharedMaxAge >= 0:
+		return time.Duration(sharedMaxAge) * time.Second, true
+	case maxAge >= 0:
+		return time.Duration(maxAge) * time.Second, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,107 @@
+package nws
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+)
+
+// TestParseFreshness tests reading response lifetimes from caching headers.
+func TestParseFreshness(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		header       map[string]string
+		wantExpires  time.Time
+		wantModified time.Time
+	}{
+		{
+			name:        "max-age",
+			header:      map[string]string{"Cache-Control": "public, max-age=600"},
+			wantExpires: now.Add(10 * time.Minute),
+		},
+		{
+			name:        "s-maxage takes precedence and Age is subtracted",
+			header:      map[string]string{"Cache-Control": "max-age=60, s-maxage=900", "Age": "300"},
+			wantExpires: now.Add(10 * time.Minute),
+		},
+		{
+			name:        "no-cache expires at once",
+			header:      map[string]string{"Cache-Control": "no-cache, max-age=600"},
+			wantExpires: now,
+		},
+		{
+			name: "Expires relative to Date",
+			header: map[string]string{
+				"Date":    "Mon, 15 Jan 2024 11:58:00 GMT",
+				"Expires": "Mon, 15 Jan 2024 12:28:00 GMT",
+			},
+			wantExpires: now.Add(30 * time.Minute),
+		},
+		{
+			name: "Last-Modified",
+			header: map[string]string{
+				"Last-Modified": "Mon, 15 Jan 2024 11:00:00 GMT",
+				"Expires":       "not a date",
+			},
+			wantModified: now.Add(-time.Hour),
+		},
+		{
+			name:   "no caching headers",
+			header: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+
+			for name, value := range tt.header {
+				header.Set(name, value)
+			}
+
+			freshness := parseFreshness(header, now)
+
+			assert.True(t, tt.wantExpires.Equal(freshness.Expires), "expires %v, want %v", freshness.Expires, tt.wantExpires)
+			assert.True(t, tt.wantModified.Equal(freshness.LastModified), "last modified %v, want %v", freshness.LastModified, tt.wantModified)
+		})
+	}
+}
+
+// TestClient_ForecastFreshness tests that forecasts carry the freshness of the NWS response.
+func TestClient_ForecastFreshness(t *testing.T) {
+	var serverURL string
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"/points/40.7128,-74.0060": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(fmt.Sprintf(pointsBody, serverURL)))
+		},
+		"/gridpoints/OKX/33,35/forecast": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Cache-Control", "public, max-age=900")
+			w.Header().Set("Last-Modified", "Mon, 15 Jan 2024 11:00:00 GMT")
+			_, _ = w.Write([]byte(`{"properties":{
+				"generatedAt":"2024-01-15T11:05:00+00:00",
+				"updateTime":"2024-01-15T10:45:00+00:00",
+				"periods":[{"name":"Today","temperature":75,"temperatureUnit":"F","shortForecast":"Sunny"}]}}`))
+		},
+	})
+	serverURL = server.URL
+
+	client := NewClient(server.URL, server.Client(), nil, Config{}, zap.NewNop())
+
+	start := time.Now()
+	data, err := client.GetForecast(context.Background(), domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060})
+
+	assert.NoError(t, err)
+	assert.WithinDuration(t, start.Add(15*time.Minute), data.Freshness.Expires, 5*time.Second)
+	assert.True(t, time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC).Equal(data.Freshness.LastModified))
+	assert.True(t, time.Date(2024, 1, 15, 11, 5, 0, 0, time.UTC).Equal(data.Freshness.GeneratedAt))
+	assert.True(t, time.Date(2024, 1, 15, 10, 45, 0, 0, time.UTC).Equal(data.Freshness.UpdatedAt))
+}
@@ -0,0 +1,169 @@
+package nws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// instrumentationName names the meter used when Config.Meter is not set.
+const instrumentationName = "github.com/sean-rowe/weather-service/internal/adapters/secondary/nws"
+
+// DefaultGridCacheTTL is how long a resolved grid is reused when Config.GridCacheTTL is not set.
+// NWS grid assignments change only when forecast offices are reorganized.
+const DefaultGridCacheTTL = 7 * 24 * time.Hour
+
+// gridCache stores /points lookups so that forecast, hourly and observation
+// requests for a known location go straight to the grid endpoints.
+type gridCache struct {
+	// cache stores the encoded /points responses
+	cache ports.CacheService
+
+	// ttl defines how long a resolved grid remains valid
+	ttl time.Duration
+
+	// hits counts lookups answered from the cache
+	hits metric.Int64Counter
+
+	// misses counts lookups that had to call /points
+	misses metric.Int64Counter
+
+	// logger records cache failures
+	logger *zap.Logger
+}
+
+// newGridCache creates a grid cache that records hit and miss counters on the given meter.
+//
+// Parameters:
+//   - cache: CacheService interface for storing grid lookups
+//   - ttl: How long a resolved grid remains valid (default: DefaultGridCacheTTL)
+//   - meter: Meter for hit/miss counters; nil uses the global meter provider
+//   - logger: Zap logger for cache failures
+//
+// Returns:
+//   - *gridCache: Configured grid cache
+func newGridCache(cache ports.CacheService, ttl time.Duration, meter metric.Meter, logger *zap.Logger) *gridCache {
+	if ttl <= 0 {
+		ttl = DefaultGridCacheTTL
+	}
+
+	if meter == nil {
+		meter = otel.Meter(instrumentationName)
+	}
+
+	// Instrument constructors return a usable no-op instrument alongside any error
+	hits, err := meter.Int64Counter(
+		"nws_grid_cache_hits_total",
+		metric.WithDescription("Total number of NWS grid lookups served from cache"),
+		metric.WithUnit("1"),
+	)
+
+	if err != nil {
+		logger.Warn("failed to create grid cache hit counter", zap.Error(err))
+	}
+
+	misses, err := meter.Int64Counter(
+		"nws_grid_cache_misses_total",
+		metric.WithDescription("Total number of NWS grid lookups that called /points"),
+		metric.WithUnit("1"),
+	)
+
+	if err != nil {
+		logger.Warn("failed to create grid cache miss counter", zap.Error(err))
+	}
+
+	return &gridCache{
+		cache:  cache,
+		ttl:    ttl,
+		hits:   hits,
+		misses: misses,
+		logger: logger,
+	}
+}
+
+// get returns the cached grid for the coordinates and records a hit or miss.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - coords: Geographic coordinates of the lookup
+//
+// Returns:
+//   - *pointsResponse: Cached grid metadata
+//   - bool: Whether a usable grid was cached
+func (g *gridCache) get(ctx context.Context, coords domain.Coordinates) (*pointsResponse, bool) {
+	points, ok := g.lookup(ctx, coords)
+
+	if ok {
+		g.hits.Add(ctx, 1)
+	} else {
+		g.misses.Add(ctx, 1)
+	}
+
+	return points, ok
+}
+
+// lookup returns the cached grid for the coordinates without recording a hit or miss.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - coords: Geographic coordinates of the lookup
+//
+// Returns:
+//   - *pointsResponse: Cached grid metadata
+//   - bool: Whether a usable grid was cached
+func (g *gridCache) lookup(ctx context.Context, coords domain.Coordinates) (*pointsResponse, bool) {
+	data, err := g.cache.Get(ctx, gridCacheKey(coords))
+
+	if err != nil {
+		return nil, false
+	}
+
+	var points pointsResponse
+
+	if err := json.Unmarshal(data, &points); err != nil {
+		g.logger.Warn("discarding undecodable cached grid", zap.Error(err))
+		return nil, false
+	}
+
+	return &points, true
+}
+
+// set caches the grid resolved for the coordinates. Failures are logged, not returned,
+// since the lookup itself succeeded.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - coords: Geographic coordinates of the lookup
+//   - points: Grid metadata returned by /points
+func (g *gridCache) set(ctx context.Context, coords domain.Coordinates, points *pointsResponse) {
+	data, err := json.Marshal(points)
+
+	if err != nil {
+		g.logger.Warn("failed to encode grid for cache", zap.Error(err))
+		return
+	}
+
+	if err := g.cache.Set(ctx, gridCacheKey(coords), data, g.ttl); err != nil {
+		g.logger.Warn("failed to cache grid", zap.Error(err))
+	}
+}
+
+// gridCacheKey builds the cache key for a /points lookup. Coordinates are
+// formatted exactly as in the /points request, so each key maps to one request.
+//
+// Parameters:
+//   - coords: Geographic coordinates of the lookup
+//
+// Returns:
+//   - string: Cache key
+func gridCacheKey(coords domain.Coordinates) string {
+	return fmt.Sprintf("nws:points:%.4f,%.4f", coords.Latitude, coords.Longitude)
+}
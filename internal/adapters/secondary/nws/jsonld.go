@@ -0,0 +1,113 @@
+package nws
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Media types NWS can respond with, selected by Config.Accept.
+const (
+	// MediaTypeGeoJSON requests GeoJSON, with fields nested under "properties"
+	MediaTypeGeoJSON = "application/geo+json"
+
+	// MediaTypeJSONLD requests JSON-LD, with fields at the top level, collections
+	// under "@graph" and geometries as WKT strings
+	MediaTypeJSONLD = "application/ld+json"
+)
+
+// ldFeature is a JSON-LD node rewritten as a GeoJSON feature.
+type ldFeature struct {
+	// Geometry is the node's point geometry; nil for other geometries
+	Geometry *ldPoint `json:"geometry,omitempty"`
+
+	// Properties holds the node's fields
+	Properties map[string]json.RawMessage `json:"properties"`
+}
+
+// ldPoint is a GeoJSON point geometry.
+type ldPoint struct {
+	// Coordinates are in GeoJSON order: longitude, latitude
+	Coordinates []float64 `json:"coordinates"`
+}
+
+// geoJSONFromLD rewrites an NWS JSON-LD document into the GeoJSON shape the
+// response types decode, so that both media types share one set of decoders.
+// A document with "@graph" becomes a feature collection; any other document
+// becomes a single feature.
+//
+// Parameters:
+//   - data: JSON-LD response body
+//
+// Returns:
+//   - []byte: Equivalent GeoJSON document
+//   - error: JSON decode error
+func geoJSONFromLD(data []byte) ([]byte, error) {
+	var doc map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	graph, ok := doc["@graph"]
+
+	if !ok {
+		return json.Marshal(toFeature(doc))
+	}
+
+	var nodes []map[string]json.RawMessage
+
+	if err := json.Unmarshal(graph, &nodes); err != nil {
+		return nil, fmt.Errorf("invalid @graph: %w", err)
+	}
+
+	features := make([]ldFeature, 0, len(nodes))
+
+	for _, node := range nodes {
+		features = append(features, toFeature(node))
+	}
+
+	return json.Marshal(map[string]interface{}{"features": features})
+}
+
+// toFeature wraps a JSON-LD node as a GeoJSON feature, parsing a WKT point geometry.
+//
+// Parameters:
+//   - node: Fields of the JSON-LD node
+//
+// Returns:
+//   - ldFeature: Feature with the node's fields as its properties
+func toFeature(node map[string]json.RawMessage) ldFeature {
+	feature := ldFeature{Properties: node}
+
+	var wkt string
+
+	if err := json.Unmarshal(node["geometry"], &wkt); err == nil {
+		feature.Geometry = parseWKTPoint(wkt)
+	}
+
+	return feature
+}
+
+// parseWKTPoint parses a WKT point such as "POINT(-73.96925 40.77898)".
+//
+// Parameters:
+//   - wkt: Well-known text geometry
+//
+// Returns:
+//   - *ldPoint: Parsed point, or nil if wkt is not a point
+func parseWKTPoint(wkt string) *ldPoint {
+	inner, ok := strings.CutPrefix(strings.TrimSpace(wkt), "POINT")
+
+	if !ok {
+		return nil
+	}
+
+	var lon, lat float64
+
+	if _, err := fmt.Sscanf(strings.TrimSpace(inner), "(%g %g)", &lon, &lat); err != nil {
+		return nil
+	}
+
+	return &ldPoint{Coordinates: []float64{lon, lat}}
+}
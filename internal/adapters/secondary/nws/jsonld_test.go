@@ -0,0 +1,68 @@
+package nws
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+)
+
+// TestClient_JSONLD tests that JSON-LD responses decode like their GeoJSON equivalents.
+func TestClient_JSONLD(t *testing.T) {
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	recent := time.Now().Add(-30 * time.Minute).UTC().Format(time.RFC3339)
+
+	var baseURL string
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"/points/40.7128,-74.0060": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, MediaTypeJSONLD, r.Header.Get("Accept"))
+
+			_, _ = fmt.Fprintf(w, `{"@context":{},"geometry":"POINT(-74.006 40.7128)",
+				"gridId":"OKX","gridX":33,"gridY":35,
+				"forecast":"%[1]s/gridpoints/OKX/33,35/forecast",
+				"observationStations":"%[1]s/gridpoints/OKX/33,35/stations"}`, baseURL)
+		},
+		"/gridpoints/OKX/33,35/forecast": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, `{"@context":{},"geometry":"POLYGON((-74.0 40.7,-74.0 40.8,-73.9 40.8,-74.0 40.7))",
+				"periods":[{"name":"Today","temperature":75,"temperatureUnit":"F","shortForecast":"Sunny"}]}`)
+		},
+		"/gridpoints/OKX/33,35/stations": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, `{"@context":{},"@graph":[
+				{"geometry":"POINT(-73.96925 40.77898)","stationIdentifier":"KNYC","name":"Central Park"}]}`)
+		},
+		"/stations/KNYC/observations/latest": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintf(w, `{"@context":{},"geometry":"POINT(-73.96925 40.77898)","timestamp":%q,
+				"temperature":{"unitCode":"wmoUnit:degC","value":21.7}}`, recent)
+		},
+		"/alerts/active": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, `{"@context":{},"@graph":[{"id":"urn:oid:1","event":"Heat Advisory","severity":"Moderate","geometry":null}]}`)
+		},
+	})
+	baseURL = server.URL
+
+	client := NewClient(server.URL, server.Client(), nil, Config{Accept: MediaTypeJSONLD}, zap.NewNop())
+
+	forecast, err := client.GetForecast(context.Background(), coords)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 75.0, forecast.Temperature)
+
+	observation, err := client.GetObservation(context.Background(), coords)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "KNYC", observation.StationID)
+	assert.InDelta(t, 8.0, observation.StationDistance.Value, 0.1, "the WKT station geometry is parsed")
+	assert.InDelta(t, 21.7, observation.Temperature.Value, 0.01)
+
+	alerts, err := client.GetAlerts(context.Background(), coords)
+
+	assert.NoError(t, err)
+	assert.Len(t, alerts, 1)
+	assert.Equal(t, "Heat Advisory", alerts[0].Event)
+}
@@ -0,0 +1,301 @@
+package nws
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/url"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+const (
+	// maxStationAttempts limits how many of the nearest stations are tried
+	// before giving up on finding a recent observation.
+	maxStationAttempts = 3
+
+	// maxObservationAge is the oldest observation still considered current.
+	maxObservationAge = 2 * time.Hour
+
+	// earthRadiusKm is the mean Earth radius used for great-circle distances.
+	earthRadiusKm = 6371.0
+)
+
+// stationsResponse represents the NWS API response from the observationStations endpoint.
+// Stations are returned ordered by distance from the requested point.
+type stationsResponse struct {
+	Features []struct {
+		Geometry struct {
+			// Coordinates are in GeoJSON order: longitude, latitude
+			Coordinates []float64 `json:"coordinates"`
+		} `json:"geometry"`
+		Properties struct {
+			StationIdentifier string `json:"stationIdentifier"`
+			Name              string `json:"name"`
+		} `json:"properties"`
+	} `json:"features"`
+}
+
+// observationResponse represents the NWS API response from /stations/{id}/observations/latest.
+type observationResponse struct {
+	Properties struct {
+		Timestamp          time.Time `json:"timestamp"`
+		TextDescription    string    `json:"textDescription"`
+		Temperature        quantity  `json:"temperature"`
+		Dewpoint           quantity  `json:"dewpoint"`
+		RelativeHumidity   quantity  `json:"relativeHumidity"`
+		WindSpeed          quantity  `json:"windSpeed"`
+		WindDirection      quantity  `json:"windDirection"`
+		BarometricPressure quantity  `json:"barometricPressure"`
+		Visibility         quantity  `json:"visibility"`
+	} `json:"properties"`
+}
+
+// quantity represents an NWS measured value with its WMO unit code.
+// Value is nil when the station did not report the measurement.
+type quantity struct {
+	UnitCode string   `json:"unitCode"`
+	Value    *float64 `json:"value"`
+}
+
+// station describes an observation station candidate.
+type station struct {
+	id       string
+	name     string
+	distance domain.Distance
+}
+
+// GetObservation retrieves the latest observation from the nearest NWS station with recent data.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout
+//   - coords: Geographic coordinates for the observation location
+//
+// Returns:
+//   - *ports.ObservationData: Measured conditions and the reporting station
+//   - error: Returns error if no stations are available or none of the
+//     nearest stations has a recent observation
+func (c *Client) GetObservation(ctx context.Context, coords domain.Coordinates) (*ports.ObservationData, error) {
+	points, err := c.getPoints(ctx, coords)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stations URL: %w", err)
+	}
+
+	if points.Properties.ObservationStations == "" {
+		return nil, fmt.Errorf("no observation stations URL in response")
+	}
+
+	stations, err := c.getStations(ctx, points.Properties.ObservationStations, coords)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get observation stations: %w", err)
+	}
+
+	if len(stations) == 0 {
+		return nil, fmt.Errorf("no observation stations available")
+	}
+
+	if len(stations) > maxStationAttempts {
+		stations = stations[:maxStationAttempts]
+	}
+
+	for _, st := range stations {
+		data, err := c.getLatestObservation(ctx, st)
+
+		if err == nil {
+			return data, nil
+		}
+
+		c.logger.Debug("station has no usable observation, trying next",
+			zap.String("station", st.id),
+			zap.Error(err),
+		)
+	}
+
+	return nil, fmt.Errorf("no recent observations from the nearest %d stations", len(stations))
+}
+
+// getStations retrieves the observation stations for a grid, nearest first.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - stationsURL: NWS observationStations URL from the points response
+//   - coords: Requested coordinates used to compute station distances
+//
+// Returns:
+//   - []station: Stations with identifiers and distances
+//   - error: HTTP error, non-200 status, or JSON decode error
+func (c *Client) getStations(ctx context.Context, stationsURL string, coords domain.Coordinates) ([]station, error) {
+	var resp stationsResponse
+
+	if _, err := c.getJSON(ctx, stationsURL, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	stations := make([]station, 0, len(resp.Features))
+
+	for _, f := range resp.Features {
+		if f.Properties.StationIdentifier == "" {
+			continue
+		}
+
+		st := station{
+			id:   f.Properties.StationIdentifier,
+			name: f.Properties.Name,
+		}
+
+		if len(f.Geometry.Coordinates) >= 2 {
+			st.distance = domain.Distance{
+				Value: haversineKm(coords.Latitude, coords.Longitude, f.Geometry.Coordinates[1], f.Geometry.Coordinates[0]),
+				Unit:  domain.Kilometers,
+			}
+		}
+
+		stations = append(stations, st)
+	}
+
+	return stations, nil
+}
+
+// getLatestObservation retrieves and validates the latest observation for a station.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - st: Station to query
+//
+// Returns:
+//   - *ports.ObservationData: Converted observation
+//   - error: HTTP error, missing temperature, or observation older than maxObservationAge
+func (c *Client) getLatestObservation(ctx context.Context, st station) (*ports.ObservationData, error) {
+	obsURL := fmt.Sprintf("%s/stations/%s/observations/latest", c.stationsBaseURL, url.PathEscape(st.id))
+
+	var resp observationResponse
+
+	if _, err := c.getJSON(ctx, obsURL, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	props := resp.Properties
+	temperature := toTemperature(props.Temperature)
+
+	if temperature == nil {
+		return nil, fmt.Errorf("station %s reported no temperature", st.id)
+	}
+
+	if props.Timestamp.IsZero() || time.Since(props.Timestamp) > maxObservationAge {
+		return nil, fmt.Errorf("station %s observation is stale (%s)", st.id, props.Timestamp.Format(time.RFC3339))
+	}
+
+	return &ports.ObservationData{
+		StationID:        st.id,
+		StationName:      st.name,
+		StationDistance:  st.distance,
+		ObservedAt:       props.Timestamp,
+		Description:      props.TextDescription,
+		Temperature:      *temperature,
+		Dewpoint:         toTemperature(props.Dewpoint),
+		RelativeHumidity: props.RelativeHumidity.Value,
+		WindSpeed:        toSpeed(props.WindSpeed),
+		WindDirection:    props.WindDirection.Value,
+		Pressure:         toPressure(props.BarometricPressure),
+		Visibility:       toDistance(props.Visibility),
+		Provider:         ProviderName,
+	}, nil
+}
+
+// toTemperature converts an NWS temperature quantity into a domain temperature.
+//
+// Parameters:
+//   - q: NWS quantity with unit code wmoUnit:degC or wmoUnit:degF
+//
+// Returns:
+//   - *domain.Temperature: Converted temperature, or nil if the value is missing
+func toTemperature(q quantity) *domain.Temperature {
+	if q.Value == nil {
+		return nil
+	}
+
+	unit := domain.Celsius
+
+	if q.UnitCode == "wmoUnit:degF" {
+		unit = domain.Fahrenheit
+	}
+
+	return &domain.Temperature{Value: *q.Value, Unit: unit}
+}
+
+// toSpeed converts an NWS speed quantity into a domain speed.
+//
+// Parameters:
+//   - q: NWS quantity with unit code wmoUnit:km_h-1 or wmoUnit:m_s-1
+//
+// Returns:
+//   - *domain.Speed: Converted speed, or nil if the value is missing
+func toSpeed(q quantity) *domain.Speed {
+	if q.Value == nil {
+		return nil
+	}
+
+	unit := domain.KilometersPerHour
+
+	if q.UnitCode == "wmoUnit:m_s-1" {
+		unit = domain.MetersPerSecond
+	}
+
+	return &domain.Speed{Value: *q.Value, Unit: unit}
+}
+
+// toPressure converts an NWS pressure quantity (pascals) into a domain pressure.
+//
+// Parameters:
+//   - q: NWS quantity with unit code wmoUnit:Pa
+//
+// Returns:
+//   - *domain.Pressure: Converted pressure, or nil if the value is missing
+func toPressure(q quantity) *domain.Pressure {
+	if q.Value == nil {
+		return nil
+	}
+
+	return &domain.Pressure{Value: *q.Value, Unit: domain.Pascal}
+}
+
+// toDistance converts an NWS length quantity (meters) into a domain distance.
+//
+// Parameters:
+//   - q: NWS quantity with unit code wmoUnit:m
+//
+// Returns:
+//   - *domain.Distance: Converted distance, or nil if the value is missing
+func toDistance(q quantity) *domain.Distance {
+	if q.Value == nil {
+		return nil
+	}
+
+	return &domain.Distance{Value: *q.Value, Unit: domain.Meters}
+}
+
+// haversineKm computes the great-circle distance between two points in kilometers.
+//
+// Parameters:
+//   - lat1, lon1: First point in decimal degrees
+//   - lat2, lon2: Second point in decimal degrees
+//
+// Returns:
+//   - float64: Distance in kilometers
+func haversineKm(lat1, lon1, lat2, lon2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(lat2 - lat1)
+	dLon := toRad(lon2 - lon1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return earthRadiusKm * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
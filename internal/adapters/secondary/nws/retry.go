@@ -0,0 +1,250 @@
+package nws
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Retry defaults applied when RetryConfig leaves a setting at zero.
+const (
+	DefaultRetryMaxAttempts = 3
+	DefaultRetryBaseDelay   = 250 * time.Millisecond
+	DefaultRetryMaxDelay    = 5 * time.Second
+)
+
+// Error classes that RetryConfig.RetryableErrors can name.
+const (
+	// ErrorClassTimeout covers requests that timed out before a response arrived
+	ErrorClassTimeout = "timeout"
+
+	// ErrorClassConnection covers refused, reset and prematurely closed connections
+	ErrorClassConnection = "connection"
+)
+
+// DefaultRetryableStatuses are the statuses retried when RetryConfig.RetryableStatuses
+// is not set: rate limiting and the transient server errors NWS returns under load.
+var DefaultRetryableStatuses = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// DefaultRetryableErrors are the error classes retried when RetryConfig.RetryableErrors is not set.
+var DefaultRetryableErrors = []string{ErrorClassTimeout, ErrorClassConnection}
+
+// RetryConfig controls how failed NWS requests are retried.
+// Zero values are replaced with sensible defaults.
+type RetryConfig struct {
+	// MaxAttempts bounds the attempts per request, including the first; 1 disables retries (default: 3)
+	MaxAttempts int
+
+	// BaseDelay is the backoff before the first retry, doubled for each later one (default: 250ms)
+	BaseDelay time.Duration
+
+	// MaxDelay caps the backoff between attempts; a longer Retry-After is still honoured (default: 5s)
+	MaxDelay time.Duration
+
+	// RetryableStatuses lists the HTTP statuses that are retried (default: DefaultRetryableStatuses)
+	RetryableStatuses []int
+
+	// RetryableErrors lists the error classes that are retried (default: DefaultRetryableErrors)
+	RetryableErrors []string
+}
+
+// retryPolicy decides whether and when a failed request is retried.
+type retryPolicy struct {
+	// maxAttempts bounds the attempts per request, including the first
+	maxAttempts int
+
+	// baseDelay is the backoff before the first retry
+	baseDelay time.Duration
+
+	// maxDelay caps the backoff between attempts
+	maxDelay time.Duration
+
+	// statuses holds the retryable HTTP statuses
+	statuses map[int]bool
+
+	// classes holds the retryable error classes
+	classes map[string]bool
+}
+
+// newRetryPolicy creates a retry policy from its configuration.
+//
+// Parameters:
+//   - cfg: Retry settings; zero values are replaced with defaults
+//
+// Returns:
+//   - retryPolicy: Policy applying the settings
+func newRetryPolicy(cfg RetryConfig) retryPolicy {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultRetryMaxAttempts
+	}
+
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = DefaultRetryBaseDelay
+	}
+
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = DefaultRetryMaxDelay
+	}
+
+	if cfg.RetryableStatuses == nil {
+		cfg.RetryableStatuses = DefaultRetryableStatuses
+	}
+
+	if cfg.RetryableErrors == nil {
+		cfg.RetryableErrors = DefaultRetryableErrors
+	}
+
+	policy := retryPolicy{
+		maxAttempts: cfg.MaxAttempts,
+		baseDelay:   cfg.BaseDelay,
+		maxDelay:    max(cfg.MaxDelay, cfg.BaseDelay),
+		statuses:    make(map[int]bool, len(cfg.RetryableStatuses)),
+		classes:     make(map[string]bool, len(cfg.RetryableErrors)),
+	}
+
+	for _, status := range cfg.RetryableStatuses {
+		policy.statuses[status] = true
+	}
+
+	for _, class := range cfg.RetryableErrors {
+		policy.classes[class] = true
+	}
+
+	return policy
+}
+
+// next decides whether a failed attempt is retried.
+//
+// Parameters:
+//   - ctx: Context of the request
+//   - attempt: Number of the attempt that failed, starting at 1
+//   - err: Error the attempt failed with
+//
+// Returns:
+//   - time.Duration: Delay before the next attempt; at least the response's Retry-After
+//   - string: Reason for the retry, either "status" or an error class
+//   - bool: Whether the request should be retried
+func (p retryPolicy) next(ctx context.Context, attempt int, err error) (time.Duration, string, bool) {
+	if attempt >= p.maxAttempts {
+		return 0, "", false
+	}
+
+	var statusErr *statusError
+
+	if errors.As(err, &statusErr) {
+		if !p.statuses[statusErr.statusCode] {
+			return 0, "", false
+		}
+
+		return max(p.backoff(attempt), statusErr.retryAfter), "status", true
+	}
+
+	class := errorClass(ctx, err)
+
+	if class == "" || !p.classes[class] {
+		return 0, "", false
+	}
+
+	return p.backoff(attempt), class, true
+}
+
+// backoff returns the jittered exponential delay before a retry. The delay doubles
+// with each attempt up to maxDelay, and a random half of it is dropped so that
+// instances failing together do not retry in lockstep.
+//
+// Parameters:
+//   - attempt: Number of the attempt that failed, starting at 1
+//
+// Returns:
+//   - time.Duration: Delay between half and all of the capped exponential delay
+func (p retryPolicy) backoff(attempt int) time.Duration {
+	delay := p.maxDelay
+
+	// Shifts past the cap would overflow, so stop doubling once the cap is reached
+	if shift := attempt - 1; shift < 32 && p.baseDelay<<shift < p.maxDelay {
+		delay = p.baseDelay << shift
+	}
+
+	half := delay / 2
+
+	return half + rand.N(delay-half+1)
+}
+
+// errorClass classifies a transport error. Only failures that may pass on their own
+// are classified: timeouts, refused and reset connections, and connections closed
+// before the response was complete. Cancellation or expiry of the caller's context,
+// certificate and other TLS failures, malformed URLs and everything else would fail
+// the same way again.
+//
+// Parameters:
+//   - ctx: Context of the request
+//   - err: Error returned by a request or while reading its response
+//
+// Returns:
+//   - string: ErrorClassTimeout, ErrorClassConnection, or empty for errors that are
+//     not transient transport failures
+func errorClass(ctx context.Context, err error) string {
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) {
+		return ""
+	}
+
+	var netErr net.Error
+
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return ErrorClassTimeout
+	}
+
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return ErrorClassConnection
+	}
+
+	// A connection closed before any response; an EOF while decoding is an empty body
+	var urlErr *url.Error
+
+	if errors.As(err, &urlErr) && errors.Is(urlErr.Err, io.EOF) {
+		return ErrorClassConnection
+	}
+
+	return ""
+}
+
+// parseRetryAfter parses a Retry-After header, given either as a number of seconds
+// or as an HTTP date.
+//
+// Parameters:
+//   - value: Header value
+//   - now: Time the response was received
+//
+// Returns:
+//   - time.Duration: Time to wait; zero if the header is missing, malformed or in the past
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0)
+	}
+
+	return 0
+}
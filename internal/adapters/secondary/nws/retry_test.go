@@ -0,0 +1,306 @@
+package nws
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+)
+
+// pointsBody is a minimal /points response pointing at the test server's forecast endpoint.
+const pointsBody = `{"properties":{"gridId":"OKX","gridX":33,"gridY":35,"forecast":"%s/gridpoints/OKX/33,35/forecast"}}`
+
+// flakyHandler fails the first failures requests with status and then serves body.
+//
+// Parameters:
+//   - calls: Counter of requests received
+//   - failures: Number of requests that fail
+//   - status: Status of the failed responses
+//   - retryAfter: Retry-After header of the failed responses; empty to omit it
+//   - body: Body of the successful response
+//
+// Returns:
+//   - http.HandlerFunc: Handler failing and then succeeding
+func flakyHandler(calls *atomic.Int32, failures int32, status int, retryAfter string, body func() string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+
+			w.WriteHeader(status)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(body()))
+	}
+}
+
+// TestClient_Retries tests retries of transient NWS failures.
+func TestClient_Retries(t *testing.T) {
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	fast := RetryConfig{BaseDelay: time.Millisecond, MaxDelay: 2 * time.Millisecond}
+	forecast := func() string {
+		return `{"properties":{"periods":[{"name":"Today","temperature":75,"temperatureUnit":"F","shortForecast":"Sunny"}]}}`
+	}
+
+	t.Run("retries transient statuses and records span events", func(t *testing.T) {
+		var calls atomic.Int32
+
+		var serverURL string
+		server := newTestServer(t, map[string]http.HandlerFunc{
+			"/points/40.7128,-74.0060": flakyHandler(&calls, 2, http.StatusServiceUnavailable, "", func() string {
+				return fmt.Sprintf(pointsBody, serverURL)
+			}),
+			"/gridpoints/OKX/33,35/forecast": func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(forecast()))
+			},
+		})
+		serverURL = server.URL
+
+		recorder := tracetest.NewSpanRecorder()
+		tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+		ctx, span := tracer.Start(context.Background(), "request")
+
+		client := NewClient(server.URL, server.Client(), nil, Config{Retry: fast}, zap.NewNop())
+		data, err := client.GetForecast(ctx, coords)
+
+		span.End()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 75.0, data.Temperature)
+		assert.Equal(t, int32(3), calls.Load())
+
+		events := recorder.Ended()[0].Events()
+		assert.Len(t, events, 2)
+
+		for i, event := range events {
+			assert.Equal(t, "nws.retry", event.Name)
+
+			attrs := map[string]interface{}{}
+
+			for _, kv := range event.Attributes {
+				attrs[string(kv.Key)] = kv.Value.AsInterface()
+			}
+
+			assert.Equal(t, int64(i+2), attrs["retry.attempt"])
+			assert.Equal(t, "status", attrs["retry.reason"])
+		}
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		var calls atomic.Int32
+
+		server := newTestServer(t, map[string]http.HandlerFunc{
+			"/points/40.7128,-74.0060": flakyHandler(&calls, 10, http.StatusBadGateway, "", forecast),
+		})
+
+		cfg := fast
+		cfg.MaxAttempts = 4
+
+		_, err := NewClient(server.URL, server.Client(), nil, Config{Retry: cfg}, zap.NewNop()).GetForecast(context.Background(), coords)
+
+		assert.ErrorContains(t, err, "status 502")
+		assert.Equal(t, int32(4), calls.Load())
+	})
+
+	t.Run("does not retry other statuses", func(t *testing.T) {
+		var calls atomic.Int32
+
+		server := newTestServer(t, map[string]http.HandlerFunc{
+			"/points/40.7128,-74.0060": flakyHandler(&calls, 10, http.StatusBadRequest, "", forecast),
+		})
+
+		_, err := NewClient(server.URL, server.Client(), nil, Config{Retry: fast}, zap.NewNop()).GetForecast(context.Background(), coords)
+
+		assert.ErrorContains(t, err, "status 400")
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("honours Retry-After", func(t *testing.T) {
+		var calls atomic.Int32
+
+		var serverURL string
+		server := newTestServer(t, map[string]http.HandlerFunc{
+			"/points/40.7128,-74.0060": flakyHandler(&calls, 1, http.StatusTooManyRequests, "1", func() string {
+				return fmt.Sprintf(pointsBody, serverURL)
+			}),
+			"/gridpoints/OKX/33,35/forecast": func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(forecast()))
+			},
+		})
+		serverURL = server.URL
+
+		start := time.Now()
+		_, err := NewClient(server.URL, server.Client(), nil, Config{Retry: fast}, zap.NewNop()).GetForecast(context.Background(), coords)
+
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+
+	t.Run("does not wait past the caller's deadline", func(t *testing.T) {
+		var calls atomic.Int32
+
+		server := newTestServer(t, map[string]http.HandlerFunc{
+			"/points/40.7128,-74.0060": flakyHandler(&calls, 10, http.StatusServiceUnavailable, "30", forecast),
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		start := time.Now()
+		_, err := NewClient(server.URL, server.Client(), nil, Config{Retry: fast}, zap.NewNop()).GetForecast(ctx, coords)
+
+		assert.ErrorContains(t, err, "status 503")
+		assert.Equal(t, int32(1), calls.Load())
+		assert.Less(t, time.Since(start), 500*time.Millisecond, "a retry that cannot start before the deadline is abandoned at once")
+	})
+
+	t.Run("retries connection errors", func(t *testing.T) {
+		var calls atomic.Int32
+
+		var serverURL string
+		server := newTestServer(t, map[string]http.HandlerFunc{
+			"/points/40.7128,-74.0060": func(w http.ResponseWriter, r *http.Request) {
+				if calls.Add(1) == 1 {
+					// Drop the connection without a response
+					conn, _, _ := w.(http.Hijacker).Hijack()
+					_ = conn.Close()
+
+					return
+				}
+
+				_, _ = w.Write([]byte(fmt.Sprintf(pointsBody, serverURL)))
+			},
+			"/gridpoints/OKX/33,35/forecast": func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(forecast()))
+			},
+		})
+		serverURL = server.URL
+
+		// A dropped keep-alive connection would be retried by the transport itself
+		httpClient := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+
+		_, err := NewClient(server.URL, httpClient, nil, Config{Retry: fast}, zap.NewNop()).GetForecast(context.Background(), coords)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), calls.Load())
+
+		calls.Store(0)
+		cfg := fast
+		cfg.RetryableErrors = []string{ErrorClassTimeout}
+
+		_, err = NewClient(server.URL, httpClient, nil, Config{Retry: cfg}, zap.NewNop()).GetForecast(context.Background(), coords)
+
+		assert.Error(t, err, "connection errors are not retried unless configured")
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
+
+// TestRetryPolicy tests backoff bounds and Retry-After parsing.
+func TestRetryPolicy(t *testing.T) {
+	policy := newRetryPolicy(RetryConfig{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, MaxAttempts: 100})
+
+	for attempt, ceiling := range map[int]time.Duration{1: 100 * time.Millisecond, 3: 400 * time.Millisecond, 10: time.Second, 80: time.Second} {
+		for range 20 {
+			delay := policy.backoff(attempt)
+			assert.GreaterOrEqual(t, delay, ceiling/2)
+			assert.LessOrEqual(t, delay, ceiling)
+		}
+	}
+
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 120*time.Second, parseRetryAfter("120", now))
+	assert.Equal(t, 90*time.Second, parseRetryAfter("Mon, 15 Jan 2024 12:01:30 GMT", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("Mon, 15 Jan 2024 11:00:00 GMT", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("soon", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("", now))
+
+	delay, reason, retry := policy.next(context.Background(), 1, &statusError{statusCode: http.StatusTooManyRequests, retryAfter: 3 * time.Second})
+	assert.True(t, retry)
+	assert.Equal(t, "status", reason)
+	assert.Equal(t, 3*time.Second, delay, "Retry-After overrides a shorter backoff")
+}
+
+// timeoutError is a net.Error reporting a timeout, like the HTTP client's own timeout.
+type timeoutError struct{}
+
+// Error describes the timeout.
+func (timeoutError) Error() string { return "Client.Timeout exceeded while awaiting headers" }
+
+// Timeout reports the error as a timeout.
+func (timeoutError) Timeout() bool { return true }
+
+// Temporary reports the error as temporary.
+func (timeoutError) Temporary() bool { return true }
+
+// TestErrorClass tests that only transient transport failures are classified as retryable.
+func TestErrorClass(t *testing.T) {
+	get := func(err error) error {
+		return &url.Error{Op: "Get", URL: "https://api.weather.gov/points/40.7128,-74.0060", Err: err}
+	}
+	dial := func(errno syscall.Errno) error {
+		return &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", errno)}
+	}
+	read := func(errno syscall.Errno) error {
+		return &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", errno)}
+	}
+	_, parseErr := url.Parse("https://api.weather.gov/points/%zz")
+
+	expired, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want string
+	}{
+		{name: "client timeout", err: get(timeoutError{}), want: ErrorClassTimeout},
+		{name: "connection refused", err: get(dial(syscall.ECONNREFUSED)), want: ErrorClassConnection},
+		{name: "connection reset", err: get(read(syscall.ECONNRESET)), want: ErrorClassConnection},
+		{name: "connection closed before a response", err: get(io.EOF), want: ErrorClassConnection},
+		{name: "truncated body", err: io.ErrUnexpectedEOF, want: ErrorClassConnection},
+		{name: "caller cancelled", err: get(context.Canceled)},
+		{name: "caller deadline passed", ctx: expired, err: get(context.DeadlineExceeded)},
+		{name: "timeout after the caller gave up", ctx: expired, err: get(timeoutError{})},
+		{name: "untrusted certificate", err: get(&tls.CertificateVerificationError{Err: x509.UnknownAuthorityError{}})},
+		{name: "hostname mismatch", err: get(x509.HostnameError{Host: "api.weather.gov"})},
+		{name: "TLS handshake with a non-TLS server", err: get(tls.RecordHeaderError{Msg: "first record does not look like a TLS handshake"})},
+		{name: "malformed URL", err: parseErr},
+		{name: "unknown host", err: get(&net.DNSError{Err: "no such host", Name: "api.weather.gov", IsNotFound: true})},
+		{name: "empty body", err: io.EOF},
+		{name: "malformed body", err: &json.SyntaxError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			assert.Equal(t, tt.want, errorClass(ctx, tt.err))
+		})
+	}
+}
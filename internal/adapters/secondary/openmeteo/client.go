@@ -0,0 +1,629 @@
+// Package openmeteo implements a client for Open-Meteo compatible forecast APIs.
+// This package serves as a secondary adapter with global coverage and no API key,
+// translating model forecasts into the provider-neutral data used by the core.
+package openmeteo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// ProviderName identifies Open-Meteo in provider configuration and in the data it returns.
+const ProviderName = "openmeteo"
+
+// Request settings shared by every call.
+const (
+	// forecastDays is the number of days requested for the multi-day forecast
+	forecastDays = 7
+
+	// forecastHours is the number of hours requested for the hourly forecast
+	forecastHours = 156
+
+	// modelStationName is reported as the station for modelled current conditions
+	modelStationName = "Open-Meteo model"
+)
+
+// ErrAlertsNotSupported is returned by GetAlerts; Open-Meteo does not publish
+// watches, warnings or advisories for any location.
+var ErrAlertsNotSupported = fmt.Errorf("%w: open-meteo does not publish weather alerts", domain.ErrLocationNotSupported)
+
+// Variables requested from the current, hourly and daily blocks.
+const (
+	currentVariables = "temperature_2m,relative_humidity_2m,dew_point_2m,precipitation_probability," +
+		"weather_code,wind_speed_10m,wind_direction_10m,surface_pressure,visibility"
+	hourlyVariables = "temperature_2m,relative_humidity_2m,dew_point_2m,precipitation_probability," +
+		"weather_code,wind_speed_10m,wind_direction_10m,is_day"
+	dailyVariables = "weather_code,temperature_2m_max,temperature_2m_min," +
+		"precipitation_probability_max,wind_speed_10m_max,wind_direction_10m_dominant"
+)
+
+// Open-Meteo timestamp layouts; times are local to the location's time zone.
+const (
+	hourLayout = "2006-01-02T15:04"
+	dayLayout  = "2006-01-02"
+)
+
+// Client implements the WeatherClient interface for Open-Meteo compatible APIs.
+// A single forecast call returns everything needed, so no grid lookup is required.
+type Client struct {
+	// baseURL is the API base endpoint (typically https://api.open-meteo.com)
+	baseURL string
+
+	// httpClient handles HTTP communication with timeout configuration
+	httpClient *http.Client
+
+	// userAgent identifies this service, and how to contact its operator, to Open-Meteo
+	userAgent string
+
+	// logger records API interactions and errors
+	logger *zap.Logger
+}
+
+// NewClient creates a new Open-Meteo API client with the specified configuration.
+//
+// Parameters:
+//   - baseURL: API base URL (typically https://api.open-meteo.com)
+//   - httpClient: HTTP client with timeout configuration
+//   - userAgent: User-Agent header value, such as "WeatherService/1.0 (ops@example.com)"
+//   - logger: Zap logger for API interaction logging
+//
+// Returns:
+//   - *Client: Configured Open-Meteo API client
+func NewClient(baseURL string, httpClient *http.Client, userAgent string, logger *zap.Logger) *Client {
+	return &Client{
+		baseURL:    baseURL,
+		httpClient: httpClient,
+		userAgent:  userAgent,
+		logger:     logger,
+	}
+}
+
+// forecastResponse represents the response from the /v1/forecast endpoint.
+// Values are nil where the model has no data.
+type forecastResponse struct {
+	UTCOffsetSeconds int           `json:"utc_offset_seconds"`
+	Current          *currentBlock `json:"current"`
+	Hourly           *hourlyBlock  `json:"hourly"`
+	Daily            *dailyBlock   `json:"daily"`
+	Reason           string        `json:"reason"`
+}
+
+// currentBlock holds the modelled conditions at the current time.
+type currentBlock struct {
+	Time                     string   `json:"time"`
+	Temperature              *float64 `json:"temperature_2m"`
+	RelativeHumidity         *float64 `json:"relative_humidity_2m"`
+	Dewpoint                 *float64 `json:"dew_point_2m"`
+	PrecipitationProbability *float64 `json:"precipitation_probability"`
+	WeatherCode              *int     `json:"weather_code"`
+	WindSpeed                *float64 `json:"wind_speed_10m"`
+	WindDirection            *float64 `json:"wind_direction_10m"`
+	SurfacePressure          *float64 `json:"surface_pressure"`
+	Visibility               *float64 `json:"visibility"`
+}
+
+// hourlyBlock holds parallel arrays with one entry per hour.
+type hourlyBlock struct {
+	Time                     []string   `json:"time"`
+	Temperature              []*float64 `json:"temperature_2m"`
+	RelativeHumidity         []*float64 `json:"relative_humidity_2m"`
+	Dewpoint                 []*float64 `json:"dew_point_2m"`
+	PrecipitationProbability []*float64 `json:"precipitation_probability"`
+	WeatherCode              []*int     `json:"weather_code"`
+	WindSpeed                []*float64 `json:"wind_speed_10m"`
+	WindDirection            []*float64 `json:"wind_direction_10m"`
+	IsDay                    []*int     `json:"is_day"`
+}
+
+// dailyBlock holds parallel arrays with one entry per day.
+type dailyBlock struct {
+	Time                     []string   `json:"time"`
+	WeatherCode              []*int     `json:"weather_code"`
+	TemperatureMax           []*float64 `json:"temperature_2m_max"`
+	TemperatureMin           []*float64 `json:"temperature_2m_min"`
+	PrecipitationProbability []*float64 `json:"precipitation_probability_max"`
+	WindSpeedMax             []*float64 `json:"wind_speed_10m_max"`
+	WindDirectionDominant    []*float64 `json:"wind_direction_10m_dominant"`
+}
+
+// GetForecast retrieves the modelled current conditions from Open-Meteo.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout
+//   - coords: Geographic coordinates for the forecast location
+//
+// Returns:
+//   - *ports.WeatherData: Current temperature, conditions, wind, precipitation
+//     probability, humidity and dewpoint
+//   - error: Returns error if the API is unavailable or returns no current data
+func (c *Client) GetForecast(ctx context.Context, coords domain.Coordinates) (*ports.WeatherData, error) {
+	forecast, err := c.fetch(ctx, coords, url.Values{"current": {currentVariables}})
+
+	if err != nil {
+		return nil, err
+	}
+
+	current := forecast.Current
+
+	if current == nil || current.Temperature == nil {
+		return nil, fmt.Errorf("no current conditions available")
+	}
+
+	description := weatherDescription(current.WeatherCode)
+
+	return &ports.WeatherData{
+		Temperature:              *current.Temperature,
+		Unit:                     domain.Celsius,
+		Forecast:                 description,
+		DetailedForecast:         description,
+		Wind:                     toWind(current.WindSpeed, current.WindSpeed, current.WindDirection),
+		PrecipitationProbability: current.PrecipitationProbability,
+		RelativeHumidity:         current.RelativeHumidity,
+		Dewpoint:                 toTemperature(current.Dewpoint),
+		Provider:                 ProviderName,
+	}, nil
+}
+
+// GetForecastPeriods retrieves the daily forecast from Open-Meteo and splits each
+// day into a daytime period carrying the high and an overnight period carrying the low.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout
+//   - coords: Geographic coordinates for the forecast location
+//
+// Returns:
+//   - *ports.ForecastData: Day and night periods for 7 days; a daytime period
+//     that has already ended is omitted
+//   - error: Returns error if the API is unavailable or returns no daily data
+func (c *Client) GetForecastPeriods(ctx context.Context, coords domain.Coordinates) (*ports.ForecastData, error) {
+	forecast, err := c.fetch(ctx, coords, url.Values{
+		"daily":         {dailyVariables},
+		"forecast_days": {strconv.Itoa(forecastDays)},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if forecast.Daily == nil || len(forecast.Daily.Time) == 0 {
+		return nil, fmt.Errorf("no forecast periods available")
+	}
+
+	periods, err := toDailyPeriods(forecast.Daily, time.FixedZone("", forecast.UTCOffsetSeconds), time.Now())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ports.ForecastData{Periods: periods, Provider: ProviderName}, nil
+}
+
+// GetHourlyForecast retrieves the hourly forecast from Open-Meteo, starting at the current hour.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout
+//   - coords: Geographic coordinates for the forecast location
+//
+// Returns:
+//   - *ports.ForecastData: One period per hour (156 hours)
+//   - error: Returns error if the API is unavailable or returns no hourly data
+func (c *Client) GetHourlyForecast(ctx context.Context, coords domain.Coordinates) (*ports.ForecastData, error) {
+	forecast, err := c.fetch(ctx, coords, url.Values{
+		"hourly":         {hourlyVariables},
+		"forecast_hours": {strconv.Itoa(forecastHours)},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if forecast.Hourly == nil || len(forecast.Hourly.Time) == 0 {
+		return nil, fmt.Errorf("no forecast periods available")
+	}
+
+	periods, err := toHourlyPeriods(forecast.Hourly, time.FixedZone("", forecast.UTCOffsetSeconds))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ports.ForecastData{Periods: periods, Provider: ProviderName}, nil
+}
+
+// GetObservation retrieves the modelled current conditions from Open-Meteo.
+// Open-Meteo has no stations; the "station" is the model grid point itself,
+// so the station distance is always zero.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout
+//   - coords: Geographic coordinates for the observation location
+//
+// Returns:
+//   - *ports.ObservationData: Current temperature, humidity, dewpoint, wind,
+//     surface pressure and visibility
+//   - error: Returns error if the API is unavailable or returns no current data
+func (c *Client) GetObservation(ctx context.Context, coords domain.Coordinates) (*ports.ObservationData, error) {
+	forecast, err := c.fetch(ctx, coords, url.Values{"current": {currentVariables}})
+
+	if err != nil {
+		return nil, err
+	}
+
+	current := forecast.Current
+
+	if current == nil || current.Temperature == nil {
+		return nil, fmt.Errorf("no current conditions available")
+	}
+
+	observedAt, err := time.ParseInLocation(hourLayout, current.Time, time.FixedZone("", forecast.UTCOffsetSeconds))
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid current time %q: %w", current.Time, err)
+	}
+
+	data := &ports.ObservationData{
+		StationName:      modelStationName,
+		StationDistance:  domain.Distance{Value: 0, Unit: domain.Kilometers},
+		ObservedAt:       observedAt,
+		Description:      weatherDescription(current.WeatherCode),
+		Temperature:      domain.Temperature{Value: *current.Temperature, Unit: domain.Celsius},
+		Dewpoint:         toTemperature(current.Dewpoint),
+		RelativeHumidity: current.RelativeHumidity,
+		WindDirection:    current.WindDirection,
+		Provider:         ProviderName,
+	}
+
+	if current.WindSpeed != nil {
+		data.WindSpeed = &domain.Speed{Value: *current.WindSpeed, Unit: domain.KilometersPerHour}
+	}
+
+	if current.SurfacePressure != nil {
+		data.Pressure = &domain.Pressure{Value: *current.SurfacePressure, Unit: domain.Hectopascal}
+	}
+
+	if current.Visibility != nil {
+		data.Visibility = &domain.Distance{Value: *current.Visibility, Unit: domain.Meters}
+	}
+
+	return data, nil
+}
+
+// GetAlerts always fails because Open-Meteo does not publish alerts; a failover
+// chain moves on to the next provider rather than reporting no alerts.
+//
+// Parameters:
+//   - ctx: Context (unused)
+//   - coords: Geographic coordinates (unused)
+//
+// Returns:
+//   - []ports.AlertData: Always nil
+//   - error: ErrAlertsNotSupported
+func (c *Client) GetAlerts(_ context.Context, _ domain.Coordinates) ([]ports.AlertData, error) {
+	return nil, ErrAlertsNotSupported
+}
+
+// LocationKey snaps the coordinates to a 0.01° lattice. Open-Meteo interpolates
+// between model grids of 1 km or coarser and does not expose a cell identifier,
+// so points on the same lattice node are treated as one location.
+//
+// Parameters:
+//   - ctx: Context (unused)
+//   - coords: Geographic coordinates to resolve
+//
+// Returns:
+//   - string: Location key of the form "openmeteo:40.71,-74.01"
+//   - error: Always nil
+func (c *Client) LocationKey(_ context.Context, coords domain.Coordinates) (string, error) {
+	return fmt.Sprintf("%s:%.2f,%.2f", ProviderName, coords.Latitude, coords.Longitude), nil
+}
+
+// CachedLocationKey returns the location key, which is computed without calling Open-Meteo.
+//
+// Parameters:
+//   - ctx: Context (unused)
+//   - coords: Geographic coordinates to resolve
+//
+// Returns:
+//   - string: Location key of the form "openmeteo:40.71,-74.01"
+//   - bool: Always true
+func (c *Client) CachedLocationKey(ctx context.Context, coords domain.Coordinates) (string, bool) {
+	key, _ := c.LocationKey(ctx, coords)
+
+	return key, true
+}
+
+// fetch calls the forecast endpoint for the coordinates with the given extra parameters.
+// Units are pinned to Celsius and km/h, and times to the location's time zone.
+//
+// Parameters:
+//   - ctx: Context for cancellation (auto-adds 10s timeout if none)
+//   - coords: Geographic coordinates for the forecast location
+//   - params: Variable selection and range parameters
+//
+// Returns:
+//   - *forecastResponse: Decoded response
+//   - error: HTTP error, non-200 status (with the API's reason), or JSON decode error
+func (c *Client) fetch(ctx context.Context, coords domain.Coordinates, params url.Values) (*forecastResponse, error) {
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+	}
+
+	params.Set("latitude", strconv.FormatFloat(coords.Latitude, 'f', 4, 64))
+	params.Set("longitude", strconv.FormatFloat(coords.Longitude, 'f', 4, 64))
+	params.Set("temperature_unit", "celsius")
+	params.Set("wind_speed_unit", "kmh")
+	params.Set("timezone", "auto")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+"/v1/forecast?"+params.Encode(), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+
+		if err != nil {
+			c.logger.Error("failed to close response body", zap.Error(err))
+		}
+	}(resp.Body)
+
+	// Error responses carry a JSON body with a "reason", so decode before checking the status
+	var forecast forecastResponse
+
+	decodeErr := json.NewDecoder(resp.Body).Decode(&forecast)
+
+	if resp.StatusCode != http.StatusOK {
+		if forecast.Reason != "" {
+			return nil, fmt.Errorf("open-meteo API returned status %d: %s", resp.StatusCode, forecast.Reason)
+		}
+
+		return nil, fmt.Errorf("open-meteo API returned status %d", resp.StatusCode)
+	}
+
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+
+	return &forecast, nil
+}
+
+// toDailyPeriods converts the daily block into alternating day and night periods.
+// Daytime runs 06:00-18:00 local time and carries the daily high; the night runs
+// 18:00-06:00 and carries the daily low.
+//
+// Parameters:
+//   - daily: Daily block from the API
+//   - loc: Time zone of the location
+//   - now: Current time; a daytime period that has ended by now is omitted
+//
+// Returns:
+//   - []ports.PeriodData: Periods in chronological order
+//   - error: Unparseable date
+func toDailyPeriods(daily *dailyBlock, loc *time.Location, now time.Time) ([]ports.PeriodData, error) {
+	periods := make([]ports.PeriodData, 0, 2*len(daily.Time))
+
+	for i, day := range daily.Time {
+		date, err := time.ParseInLocation(dayLayout, day, loc)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid forecast date %q: %w", day, err)
+		}
+
+		dayName, nightName := date.Weekday().String(), date.Weekday().String()+" Night"
+
+		if i == 0 {
+			dayName, nightName = "Today", "Tonight"
+		}
+
+		description := weatherDescription(at(daily.WeatherCode, i))
+		wind := toWind(nil, at(daily.WindSpeedMax, i), at(daily.WindDirectionDominant, i))
+		dayStart := date.Add(6 * time.Hour)
+		nightStart := date.Add(18 * time.Hour)
+
+		if high := at(daily.TemperatureMax, i); high != nil && nightStart.After(now) {
+			periods = append(periods, ports.PeriodData{
+				Name:                     dayName,
+				StartTime:                dayStart,
+				EndTime:                  nightStart,
+				IsDaytime:                true,
+				Temperature:              *high,
+				Unit:                     domain.Celsius,
+				ShortForecast:            description,
+				DetailedForecast:         description,
+				Wind:                     wind,
+				PrecipitationProbability: at(daily.PrecipitationProbability, i),
+			})
+		}
+
+		if low := at(daily.TemperatureMin, i); low != nil {
+			periods = append(periods, ports.PeriodData{
+				Name:                     nightName,
+				StartTime:                nightStart,
+				EndTime:                  date.AddDate(0, 0, 1).Add(6 * time.Hour),
+				IsDaytime:                false,
+				Temperature:              *low,
+				Unit:                     domain.Celsius,
+				ShortForecast:            description,
+				DetailedForecast:         description,
+				Wind:                     wind,
+				PrecipitationProbability: at(daily.PrecipitationProbability, i),
+			})
+		}
+	}
+
+	return periods, nil
+}
+
+// toHourlyPeriods converts the hourly block into one period per hour.
+// Hours without a temperature are skipped.
+//
+// Parameters:
+//   - hourly: Hourly block from the API
+//   - loc: Time zone of the location
+//
+// Returns:
+//   - []ports.PeriodData: Hourly periods in chronological order
+//   - error: Unparseable time
+func toHourlyPeriods(hourly *hourlyBlock, loc *time.Location) ([]ports.PeriodData, error) {
+	periods := make([]ports.PeriodData, 0, len(hourly.Time))
+
+	for i, hour := range hourly.Time {
+		start, err := time.ParseInLocation(hourLayout, hour, loc)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid forecast time %q: %w", hour, err)
+		}
+
+		temperature := at(hourly.Temperature, i)
+
+		if temperature == nil {
+			continue
+		}
+
+		isDay := at(hourly.IsDay, i)
+		description := weatherDescription(at(hourly.WeatherCode, i))
+
+		periods = append(periods, ports.PeriodData{
+			StartTime:                start,
+			EndTime:                  start.Add(time.Hour),
+			IsDaytime:                isDay != nil && *isDay == 1,
+			Temperature:              *temperature,
+			Unit:                     domain.Celsius,
+			ShortForecast:            description,
+			DetailedForecast:         description,
+			Wind:                     toWind(at(hourly.WindSpeed, i), at(hourly.WindSpeed, i), at(hourly.WindDirection, i)),
+			PrecipitationProbability: at(hourly.PrecipitationProbability, i),
+			RelativeHumidity:         at(hourly.RelativeHumidity, i),
+			Dewpoint:                 toTemperature(at(hourly.Dewpoint, i)),
+		})
+	}
+
+	return periods, nil
+}
+
+// at returns the i-th element of a parallel array, or nil if the array is short.
+func at[T any](values []*T, i int) *T {
+	if i >= len(values) {
+		return nil
+	}
+
+	return values[i]
+}
+
+// toTemperature converts an optional Celsius value into a domain temperature.
+func toTemperature(value *float64) *domain.Temperature {
+	if value == nil {
+		return nil
+	}
+
+	return &domain.Temperature{Value: *value, Unit: domain.Celsius}
+}
+
+// toWind converts optional km/h speeds and a direction in degrees into a wind range.
+// A nil minimum is treated as calm, as for daily maxima.
+//
+// Parameters:
+//   - minSpeed: Lower end of the range in km/h, nil for calm
+//   - maxSpeed: Upper end of the range in km/h
+//   - direction: Direction the wind blows from, in degrees
+//
+// Returns:
+//   - *domain.Wind: Wind range, or nil if the maximum speed is missing
+func toWind(minSpeed, maxSpeed, direction *float64) *domain.Wind {
+	if maxSpeed == nil {
+		return nil
+	}
+
+	wind := &domain.Wind{
+		MaxSpeed: domain.Speed{Value: *maxSpeed, Unit: domain.KilometersPerHour},
+		MinSpeed: domain.Speed{Value: 0, Unit: domain.KilometersPerHour},
+	}
+
+	if minSpeed != nil {
+		wind.MinSpeed.Value = *minSpeed
+	}
+
+	if direction != nil {
+		wind.Direction = compassPoint(*direction)
+	}
+
+	return wind
+}
+
+// compassPoints are the 16 compass points, clockwise from north.
+var compassPoints = []string{
+	"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE",
+	"S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW",
+}
+
+// compassPoint converts a direction in degrees into the nearest 16-point compass direction.
+func compassPoint(degrees float64) string {
+	index := int(math.Round(math.Mod(math.Mod(degrees, 360)+360, 360)/22.5)) % len(compassPoints)
+
+	return compassPoints[index]
+}
+
+// weatherDescriptions maps WMO weather interpretation codes to descriptions.
+var weatherDescriptions = map[int]string{
+	0:  "Clear",
+	1:  "Mostly Clear",
+	2:  "Partly Cloudy",
+	3:  "Overcast",
+	45: "Fog",
+	48: "Freezing Fog",
+	51: "Light Drizzle",
+	53: "Drizzle",
+	55: "Heavy Drizzle",
+	56: "Light Freezing Drizzle",
+	57: "Freezing Drizzle",
+	61: "Light Rain",
+	63: "Rain",
+	65: "Heavy Rain",
+	66: "Light Freezing Rain",
+	67: "Freezing Rain",
+	71: "Light Snow",
+	73: "Snow",
+	75: "Heavy Snow",
+	77: "Snow Grains",
+	80: "Light Rain Showers",
+	81: "Rain Showers",
+	82: "Heavy Rain Showers",
+	85: "Light Snow Showers",
+	86: "Heavy Snow Showers",
+	95: "Thunderstorms",
+	96: "Thunderstorms With Hail",
+	99: "Severe Thunderstorms With Hail",
+}
+
+// weatherDescription converts an optional WMO weather code into a description.
+// Unknown or missing codes yield an empty description.
+func weatherDescription(code *int) string {
+	if code == nil {
+		return ""
+	}
+
+	return weatherDescriptions[*code]
+}
@@ -0,0 +1,272 @@
+// Package openmeteo contains unit tests for the Open-Meteo API client.
+package openmeteo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+)
+
+// testUserAgent is the User-Agent the clients under test are configured to send.
+const testUserAgent = "acme-weather/2.3 (ops@example.com)"
+
+// newTestServer starts an httptest stand-in for the Open-Meteo forecast endpoint.
+// The handler receives every /v1/forecast request; any other path returns 404.
+//
+// Parameters:
+//   - t: Test instance used to register server cleanup
+//   - handler: Handler for /v1/forecast
+//
+// Returns:
+//   - *httptest.Server: Running test server
+func newTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/forecast", handler)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// TestClient_GetForecast tests decoding of modelled current conditions.
+func TestClient_GetForecast(t *testing.T) {
+	coords := domain.Coordinates{Latitude: 51.5074, Longitude: -0.1278}
+
+	t.Run("current conditions", func(t *testing.T) {
+		server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "51.5074", r.URL.Query().Get("latitude"))
+			assert.Equal(t, "-0.1278", r.URL.Query().Get("longitude"))
+			assert.Contains(t, r.URL.Query().Get("current"), "temperature_2m")
+			assert.Equal(t, "celsius", r.URL.Query().Get("temperature_unit"))
+			assert.Equal(t, testUserAgent, r.Header.Get("User-Agent"))
+
+			_, _ = fmt.Fprint(w, `{"utc_offset_seconds":3600,"current":{
+				"time":"2024-06-01T14:00",
+				"temperature_2m":18.4,
+				"relative_humidity_2m":62,
+				"dew_point_2m":11.0,
+				"precipitation_probability":20,
+				"weather_code":2,
+				"wind_speed_10m":14.8,
+				"wind_direction_10m":225
+			}}`)
+		})
+
+		data, err := NewClient(server.URL, server.Client(), testUserAgent, zap.NewNop()).GetForecast(context.Background(), coords)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 18.4, data.Temperature)
+		assert.Equal(t, domain.Celsius, data.Unit)
+		assert.Equal(t, "Partly Cloudy", data.Forecast)
+		assert.Equal(t, ProviderName, data.Provider)
+		assert.Equal(t, 62.0, *data.RelativeHumidity)
+		assert.Equal(t, 20.0, *data.PrecipitationProbability)
+		assert.Equal(t, domain.Temperature{Value: 11, Unit: domain.Celsius}, *data.Dewpoint)
+		assert.Equal(t, domain.Speed{Value: 14.8, Unit: domain.KilometersPerHour}, data.Wind.MaxSpeed)
+		assert.Equal(t, "SW", data.Wind.Direction)
+	})
+
+	t.Run("API error reports the reason", func(t *testing.T) {
+		server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprint(w, `{"error":true,"reason":"Latitude must be in range of -90 to 90°."}`)
+		})
+
+		_, err := NewClient(server.URL, server.Client(), testUserAgent, zap.NewNop()).GetForecast(context.Background(), coords)
+
+		assert.ErrorContains(t, err, "status 400: Latitude must be in range")
+	})
+
+	t.Run("missing current temperature", func(t *testing.T) {
+		server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, `{"current":{"time":"2024-06-01T14:00","temperature_2m":null}}`)
+		})
+
+		_, err := NewClient(server.URL, server.Client(), testUserAgent, zap.NewNop()).GetForecast(context.Background(), coords)
+
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_GetForecastPeriods tests splitting daily forecasts into day and night periods.
+func TestClient_GetForecastPeriods(t *testing.T) {
+	coords := domain.Coordinates{Latitude: 51.5074, Longitude: -0.1278}
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	dayAfter := tomorrow.AddDate(0, 0, 1)
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "7", r.URL.Query().Get("forecast_days"))
+
+		_, _ = fmt.Fprintf(w, `{"utc_offset_seconds":0,"daily":{
+			"time":[%q,%q],
+			"weather_code":[61,0],
+			"temperature_2m_max":[21.5,24.0],
+			"temperature_2m_min":[12.1,null],
+			"precipitation_probability_max":[80,5],
+			"wind_speed_10m_max":[25.0,10.0],
+			"wind_direction_10m_dominant":[270,90]
+		}}`, tomorrow.Format(dayLayout), dayAfter.Format(dayLayout))
+	})
+
+	forecast, err := NewClient(server.URL, server.Client(), testUserAgent, zap.NewNop()).GetForecastPeriods(context.Background(), coords)
+
+	assert.NoError(t, err)
+	assert.Equal(t, ProviderName, forecast.Provider)
+	assert.Len(t, forecast.Periods, 3, "a day without a low has no overnight period")
+
+	today, tonight := forecast.Periods[0], forecast.Periods[1]
+
+	assert.Equal(t, "Today", today.Name)
+	assert.True(t, today.IsDaytime)
+	assert.Equal(t, 21.5, today.Temperature)
+	assert.Equal(t, "Light Rain", today.ShortForecast)
+	assert.Equal(t, 80.0, *today.PrecipitationProbability)
+	assert.Equal(t, "W", today.Wind.Direction)
+	assert.Equal(t, 6, today.StartTime.Hour())
+	assert.Equal(t, today.EndTime, tonight.StartTime)
+
+	assert.Equal(t, "Tonight", tonight.Name)
+	assert.False(t, tonight.IsDaytime)
+	assert.Equal(t, 12.1, tonight.Temperature)
+	assert.Equal(t, 12*time.Hour, tonight.EndTime.Sub(tonight.StartTime))
+
+	assert.Equal(t, dayAfter.Weekday().String(), forecast.Periods[2].Name)
+}
+
+// TestToDailyPeriods tests that a daytime period is dropped once it has ended.
+func TestToDailyPeriods(t *testing.T) {
+	high, low := 20.0, 10.0
+	daily := &dailyBlock{
+		Time:           []string{"2024-06-01"},
+		TemperatureMax: []*float64{&high},
+		TemperatureMin: []*float64{&low},
+	}
+
+	evening := time.Date(2024, 6, 1, 19, 0, 0, 0, time.UTC)
+	periods, err := toDailyPeriods(daily, time.UTC, evening)
+
+	assert.NoError(t, err)
+	assert.Len(t, periods, 1)
+	assert.Equal(t, "Tonight", periods[0].Name)
+}
+
+// TestClient_GetHourlyForecast tests decoding of hourly periods.
+func TestClient_GetHourlyForecast(t *testing.T) {
+	coords := domain.Coordinates{Latitude: 35.6762, Longitude: 139.6503}
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "156", r.URL.Query().Get("forecast_hours"))
+
+		_, _ = fmt.Fprint(w, `{"utc_offset_seconds":32400,"hourly":{
+			"time":["2024-06-01T18:00","2024-06-01T19:00","2024-06-01T20:00"],
+			"temperature_2m":[24.1,null,22.0],
+			"relative_humidity_2m":[70,72,75],
+			"dew_point_2m":[18.2,18.0,17.5],
+			"precipitation_probability":[10,15,30],
+			"weather_code":[3,3,80],
+			"wind_speed_10m":[9.4,8.0,7.2],
+			"wind_direction_10m":[180,185,10],
+			"is_day":[1,0,0]
+		}}`)
+	})
+
+	forecast, err := NewClient(server.URL, server.Client(), testUserAgent, zap.NewNop()).GetHourlyForecast(context.Background(), coords)
+
+	assert.NoError(t, err)
+	assert.Equal(t, ProviderName, forecast.Provider)
+	assert.Len(t, forecast.Periods, 2, "hours without a temperature are skipped")
+
+	first := forecast.Periods[0]
+
+	assert.Equal(t, time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC), first.StartTime.UTC())
+	assert.Equal(t, time.Hour, first.EndTime.Sub(first.StartTime))
+	assert.True(t, first.IsDaytime)
+	assert.Equal(t, "Overcast", first.ShortForecast)
+	assert.Equal(t, 70.0, *first.RelativeHumidity)
+
+	last := forecast.Periods[1]
+
+	assert.False(t, last.IsDaytime)
+	assert.Equal(t, "Light Rain Showers", last.ShortForecast)
+	assert.Equal(t, "N", last.Wind.Direction)
+}
+
+// TestClient_GetObservation tests modelled current conditions reported as an observation.
+func TestClient_GetObservation(t *testing.T) {
+	coords := domain.Coordinates{Latitude: -33.8688, Longitude: 151.2093}
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"utc_offset_seconds":36000,"current":{
+			"time":"2024-06-01T09:15",
+			"temperature_2m":14.2,
+			"relative_humidity_2m":80,
+			"dew_point_2m":10.8,
+			"weather_code":45,
+			"wind_speed_10m":5.0,
+			"wind_direction_10m":350,
+			"surface_pressure":1016.2,
+			"visibility":800
+		}}`)
+	})
+
+	observation, err := NewClient(server.URL, server.Client(), testUserAgent, zap.NewNop()).GetObservation(context.Background(), coords)
+
+	assert.NoError(t, err)
+	assert.Equal(t, ProviderName, observation.Provider)
+	assert.Equal(t, modelStationName, observation.StationName)
+	assert.Equal(t, time.Date(2024, 5, 31, 23, 15, 0, 0, time.UTC), observation.ObservedAt.UTC())
+	assert.Equal(t, "Fog", observation.Description)
+	assert.Equal(t, domain.Temperature{Value: 14.2, Unit: domain.Celsius}, observation.Temperature)
+	assert.Equal(t, domain.Speed{Value: 5, Unit: domain.KilometersPerHour}, *observation.WindSpeed)
+	assert.Equal(t, domain.Pressure{Value: 1016.2, Unit: domain.Hectopascal}, *observation.Pressure)
+	assert.Equal(t, domain.Distance{Value: 800, Unit: domain.Meters}, *observation.Visibility)
+}
+
+// TestClient_GetAlerts tests that alerts are reported as unsupported rather than failing.
+func TestClient_GetAlerts(t *testing.T) {
+	_, err := NewClient("http://unused", http.DefaultClient, testUserAgent, zap.NewNop()).GetAlerts(context.Background(), domain.Coordinates{})
+
+	assert.ErrorIs(t, err, ErrAlertsNotSupported)
+	assert.ErrorIs(t, err, domain.ErrLocationNotSupported, "a failover chain treats it like an uncovered location")
+}
+
+// TestClient_LocationKey tests that nearby points share a location key.
+func TestClient_LocationKey(t *testing.T) {
+	client := NewClient("http://unused", http.DefaultClient, testUserAgent, zap.NewNop())
+
+	first, err := client.LocationKey(context.Background(), domain.Coordinates{Latitude: 51.5074, Longitude: -0.1278})
+	assert.NoError(t, err)
+	assert.Equal(t, "openmeteo:51.51,-0.13", first)
+
+	nearby, _ := client.LocationKey(context.Background(), domain.Coordinates{Latitude: 51.5051, Longitude: -0.1302})
+	assert.Equal(t, first, nearby)
+}
+
+// TestCompassPoint tests conversion of degrees to compass points.
+func TestCompassPoint(t *testing.T) {
+	tests := map[float64]string{
+		0:     "N",
+		11.2:  "N",
+		11.3:  "NNE",
+		90:    "E",
+		202.5: "SSW",
+		348.8: "N",
+		360:   "N",
+		-90:   "W",
+	}
+
+	for degrees, expected := range tests {
+		assert.Equal(t, expected, compassPoint(degrees), "degrees %v", degrees)
+	}
+}
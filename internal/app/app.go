@@ -19,14 +19,20 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/sean-rowe/weather-service/internal/adapters/primary/rest"
+	"github.com/sean-rowe/weather-service/internal/adapters/secondary/coverage"
+	"github.com/sean-rowe/weather-service/internal/adapters/secondary/failover"
+	"github.com/sean-rowe/weather-service/internal/adapters/secondary/geocoding"
 	"github.com/sean-rowe/weather-service/internal/adapters/secondary/nws"
+	"github.com/sean-rowe/weather-service/internal/adapters/secondary/openmeteo"
 	"github.com/sean-rowe/weather-service/internal/config"
+	"github.com/sean-rowe/weather-service/internal/core/domain"
 	"github.com/sean-rowe/weather-service/internal/core/ports"
 	"github.com/sean-rowe/weather-service/internal/core/services"
 	"github.com/sean-rowe/weather-service/internal/infrastructure/cache"
 	"github.com/sean-rowe/weather-service/internal/infrastructure/circuitbreaker"
 	"github.com/sean-rowe/weather-service/internal/infrastructure/database"
 	"github.com/sean-rowe/weather-service/internal/infrastructure/ratelimit"
+	"github.com/sean-rowe/weather-service/internal/infrastructure/warmer"
 	"github.com/sean-rowe/weather-service/internal/middleware"
 	"github.com/sean-rowe/weather-service/internal/observability"
 	"github.com/sean-rowe/weather-service/internal/version"
@@ -45,6 +51,12 @@ type App struct {
 	logger    *zap.Logger
 	telemetry *observability.Telemetry
 	db        *database.PostgresDB
+
+	// stopWarmer cancels the cache warmer; nil when the warmer is not running
+	stopWarmer context.CancelFunc
+
+	// closeCache stops the tiered cache's invalidation subscriber; nil when no tiered cache is used
+	closeCache func() error
 }
 
 // New creates a new application instance.
@@ -85,15 +97,70 @@ func (a *App) Start(ctx context.Context) error {
 		a.logger.Warn("failed to connect to database, continuing without it", zap.Error(err))
 	}
 
-	weatherClient := a.initWeatherClient()
+	weatherClient, err := a.initWeatherClient(cacheService)
+
+	if err != nil {
+		return err
+	}
+
 	// Create database adapter if database is available
 	var dbRepo ports.DatabaseRepository
 	if a.db != nil {
 		dbRepo = NewDatabaseAdapter(a.db)
 	}
 	
-	weatherService := services.NewWeatherService(weatherClient, cacheService, dbRepo, a.logger)
-	weatherHandler := rest.NewWeatherHandler(weatherService, a.logger)
+	profiles, err := config.LoadCategoryProfiles(a.cfg.Categories.ProfilesFile)
+
+	if err != nil {
+		return err
+	}
+
+	serviceCfg := services.Config{
+		CacheTTL:                  a.cfg.Cache.WeatherTTL,
+		HourlyCacheTTL:            a.cfg.Cache.HourlyTTL,
+		ObservationCacheTTL:       a.cfg.Cache.ObservationTTL,
+		AlertsCacheTTL:            a.cfg.Cache.AlertsTTL,
+		WeatherMaxStale:           a.cfg.Cache.WeatherMaxStale,
+		ForecastMaxStale:          a.cfg.Cache.ForecastMaxStale,
+		HourlyMaxStale:            a.cfg.Cache.HourlyMaxStale,
+		ObservationMaxStale:       a.cfg.Cache.ObservationMaxStale,
+		AlertsMaxStale:            a.cfg.Cache.AlertsMaxStale,
+		Profiles:                  profiles,
+		DefaultProfile:            a.cfg.Categories.DefaultProfile,
+		LockTTL:                   a.cfg.Cache.LockTTL,
+		CacheCodec:                a.cfg.Cache.Codec,
+		CacheCompression:          a.cfg.Cache.Compression,
+		CacheCompressionThreshold: a.cfg.Cache.CompressionMinBytes,
+		MinUpstreamTTL:            a.cfg.Cache.UpstreamMinTTL,
+		MaxUpstreamTTL:            a.cfg.Cache.UpstreamMaxTTL,
+	}
+
+	if a.cfg.Cache.DistributedLock {
+		if locker, ok := cacheService.(ports.LockService); ok {
+			serviceCfg.Locker = locker
+		} else {
+			a.logger.Warn("distributed fetch lock requires Redis, coalescing fetches per instance only")
+		}
+	}
+
+	geocoder, err := a.initGeocoder()
+
+	if err != nil {
+		return err
+	}
+
+	weatherService := services.NewWeatherService(weatherClient, cacheService, dbRepo, serviceCfg, a.logger)
+	locationService := services.NewLocationService(geocoder, cacheService, services.LocationConfig{
+		CacheTTL: a.cfg.Geocoding.CacheTTL,
+	}, a.logger)
+	weatherHandler := rest.NewWeatherHandler(weatherService, locationService, rest.HandlerConfig{
+		APIKeyProfiles:   a.cfg.Categories.APIKeyProfiles,
+		BatchMaxItems:    a.cfg.Batch.MaxItems,
+		BatchConcurrency: a.cfg.Batch.Concurrency,
+		BatchItemCost:    a.cfg.Batch.ItemCost,
+	}, a.logger)
+
+	a.startWarmer(weatherService, dbRepo)
 
 	rateLimitMiddleware := middleware.NewRateLimitMiddleware(
 		rateLimitService,
@@ -133,6 +200,10 @@ func (a *App) Start(ctx context.Context) error {
 func (a *App) Stop() {
 	a.logger.Info("shutting down application...")
 
+	if a.stopWarmer != nil {
+		a.stopWarmer()
+	}
+
 	if a.server != nil {
 		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
@@ -142,6 +213,12 @@ func (a *App) Stop() {
 		}
 	}
 
+	if a.closeCache != nil {
+		if err := a.closeCache(); err != nil {
+			a.logger.Error("failed to close cache", zap.Error(err))
+		}
+	}
+
 	if a.db != nil {
 		if err := a.db.Close(); err != nil {
 			a.logger.Error("failed to close database connection", zap.Error(err))
@@ -173,6 +250,41 @@ func (a *App) WaitForShutdown() {
 	a.logger.Info("shutdown signal received")
 }
 
+// startWarmer starts the background cache warmer when it is enabled and has
+// locations to warm: a static list from config, or popular locations from the database.
+//
+// Parameters:
+//   - weatherService: Service whose cache entries are refreshed
+//   - dbRepo: Database supplying popular locations (can be nil)
+func (a *App) startWarmer(weatherService ports.WeatherService, dbRepo ports.DatabaseRepository) {
+	if !a.cfg.Warmer.Enabled {
+		return
+	}
+
+	if len(a.cfg.Warmer.Locations) == 0 && dbRepo == nil {
+		a.logger.Warn("cache warmer needs WARMER_LOCATIONS or a database, not starting it")
+		return
+	}
+
+	warmerCfg := warmer.Config{
+		Interval:             a.cfg.Warmer.Interval,
+		Jitter:               a.cfg.Warmer.Jitter,
+		TopN:                 a.cfg.Warmer.TopN,
+		Lookback:             a.cfg.Warmer.Lookback,
+		Locations:            a.cfg.Warmer.Locations,
+		MaxRequestsPerMinute: a.cfg.Warmer.MaxRequestsPerMinute,
+	}
+
+	if a.telemetry != nil {
+		warmerCfg.Meter = a.telemetry.Meter
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.stopWarmer = cancel
+
+	go warmer.New(weatherService, dbRepo, warmerCfg, a.logger).Run(ctx)
+}
+
 // initTelemetry initializes OpenTelemetry providers.
 //
 // Parameters:
@@ -196,18 +308,19 @@ func (a *App) initTelemetry(ctx context.Context) error {
 }
 
 // initRedisServices initializes Redis-based or memory-based cache and rate limiting.
+// In Redis mode the cache is fronted by an in-process L1 cache unless it is disabled.
 //
 // Parameters:
 //   - ctx: Context for Redis connection testing
 //
 // Returns:
-//   - ports.CacheService: Cache implementation (Redis or memory)
+//   - ports.CacheService: Cache implementation (tiered, Redis or memory)
 //   - ports.RateLimitService: Rate limiter implementation (Redis or memory)
 func (a *App) initRedisServices(ctx context.Context) (ports.CacheService, ports.RateLimitService) {
 	if !a.cfg.Redis.Enabled {
 		a.logger.Info("Redis disabled, using memory-based services")
 
-		memCache := cache.NewMemoryCache(5*time.Minute, 10*time.Minute, a.logger)
+		memCache := a.newMemoryCache()
 		memRateLimit := middleware.NewMemoryRateLimiter(a.logger)
 
 		return memCache, memRateLimit
@@ -228,7 +341,7 @@ func (a *App) initRedisServices(ctx context.Context) (ports.CacheService, ports.
 	if err := redisClient.Ping(ctx).Err(); err != nil {
 		a.logger.Warn("Redis connection failed, falling back to memory-based services", zap.Error(err))
 
-		memCache := cache.NewMemoryCache(5*time.Minute, 10*time.Minute, a.logger)
+		memCache := a.newMemoryCache()
 		memRateLimit := middleware.NewMemoryRateLimiter(a.logger)
 
 		return memCache, memRateLimit
@@ -237,6 +350,7 @@ func (a *App) initRedisServices(ctx context.Context) (ports.CacheService, ports.
 	a.logger.Info("Redis connected successfully")
 
 	redisCfg := cache.Config{
+		Namespace:    a.cfg.Cache.Namespace,
 		Addr:         a.cfg.Redis.Addr,
 		Password:     a.cfg.Redis.Password,
 		DB:           a.cfg.Redis.DB,
@@ -251,7 +365,51 @@ func (a *App) initRedisServices(ctx context.Context) (ports.CacheService, ports.
 	cacheService, _ := cache.NewRedisCache(redisCfg, a.logger)
 	rateLimitService := ratelimit.NewRedisRateLimiter(redisClient, a.logger)
 
-	return cacheService, rateLimitService
+	if !a.cfg.Cache.L1Enabled {
+		return cacheService, rateLimitService
+	}
+
+	tieredCfg := cache.TieredConfig{
+		L1TTL:        a.cfg.Cache.L1TTL,
+		L1MaxEntries: a.cfg.Cache.L1MaxEntries,
+		L1MaxBytes:   int64(a.cfg.Cache.L1MaxBytes),
+	}
+
+	if a.cfg.Cache.Namespace != "" {
+		tieredCfg.Channel = a.cfg.Cache.Namespace + ":invalidate"
+	}
+
+	if a.telemetry != nil {
+		tieredCfg.Meter = a.telemetry.Meter
+	}
+
+	tieredCache, err := cache.NewTieredCache(cacheService, redisClient, tieredCfg, a.logger)
+
+	if err != nil {
+		a.logger.Warn("failed to subscribe to cache invalidations, using Redis cache without L1", zap.Error(err))
+		return cacheService, rateLimitService
+	}
+
+	a.closeCache = tieredCache.Close
+
+	return tieredCache, rateLimitService
+}
+
+// newMemoryCache creates the bounded in-memory cache used when Redis is unavailable.
+//
+// Returns:
+//   - ports.CacheService: In-memory cache recording its metrics with the service's meter
+func (a *App) newMemoryCache() ports.CacheService {
+	memoryCfg := cache.MemoryConfig{
+		MaxEntries: a.cfg.Cache.MemoryMaxEntries,
+		MaxBytes:   int64(a.cfg.Cache.MemoryMaxBytes),
+	}
+
+	if a.telemetry != nil {
+		memoryCfg.Meter = a.telemetry.Meter
+	}
+
+	return cache.NewMemoryCache(memoryCfg, a.logger)
 }
 
 // initDatabase initializes PostgreSQL database connection.
@@ -332,30 +490,178 @@ func (a *App) setupRouter(
 
 	// Weather endpoints
 	api.HandleFunc("/weather", weatherHandler.GetWeather).Methods("GET")
+	api.HandleFunc("/forecast", weatherHandler.GetForecast).Methods("GET")
+	api.HandleFunc("/forecast/hourly", weatherHandler.GetHourlyForecast).Methods("GET")
+	api.HandleFunc("/observations", weatherHandler.GetObservation).Methods("GET")
+	api.HandleFunc("/alerts", weatherHandler.GetAlerts).Methods("GET")
+
+	// Batches are charged per location on top of the per-request limit
+	var batchHandler http.Handler = http.HandlerFunc(weatherHandler.GetWeatherBatch)
+
+	if rateLimitMiddleware != nil {
+		batchHandler = rateLimitMiddleware.Weighted(weatherHandler.BatchCost)(batchHandler)
+	}
+
+	api.Handle("/weather/batch", batchHandler).Methods("POST")
 
 	return router
 }
 
-// initWeatherClient creates a weather client with circuit breaker protection.
+// userAgent returns the User-Agent header value sent to every external API, built
+// from the NWS product token and contact so that each provider sees the same identity.
+//
+// Returns:
+//   - string: Product token followed by the contact, if any
+func (a *App) userAgent() string {
+	return nws.FormatUserAgent(a.cfg.External.NWSUserAgent, a.cfg.External.NWSContact)
+}
+
+// initGeocoder creates the configured geocoder.
+//
+// Returns:
+//   - ports.Geocoder: Offline gazetteer, Open-Meteo and Census HTTP geocoders, or all chained
+//   - error: Gazetteer load error or unknown provider
+func (a *App) initGeocoder() (ports.Geocoder, error) {
+	var (
+		gazetteer *geocoding.Gazetteer
+		err       error
+	)
+
+	if a.cfg.Geocoding.GazetteerFile != "" {
+		gazetteer, err = geocoding.LoadGazetteerFile(a.cfg.Geocoding.GazetteerFile)
+	} else {
+		gazetteer, err = geocoding.NewGazetteer()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := &http.Client{
+		Timeout: a.cfg.External.HTTPTimeout,
+	}
+
+	// The Census geocoder only matches street addresses, so ZIP codes and
+	// places go to Open-Meteo first
+	online := geocoding.NewChain(
+		geocoding.NewOpenMeteoGeocoder(a.cfg.Geocoding.PlacesURL, httpClient, a.userAgent(), a.logger),
+		geocoding.NewCensusGeocoder(a.cfg.Geocoding.CensusURL, httpClient, a.userAgent(), a.logger),
+	)
+
+	switch a.cfg.Geocoding.Provider {
+	case "gazetteer":
+		return gazetteer, nil
+	case "census":
+		return online, nil
+	case "chain":
+		return geocoding.NewChain(gazetteer, online), nil
+	default:
+		return nil, fmt.Errorf("unknown geocoder %q", a.cfg.Geocoding.Provider)
+	}
+}
+
+// initWeatherClient creates a failover weather client over the configured providers.
+// Each provider is wrapped in its own circuit breaker and limited to its embedded
+// coverage, and providers are tried in the configured priority order. Locations a
+// provider does not support are not counted as failures by its breaker. The NWS
+// client retries transient failures itself, so its breaker only sees a failure once
+// every retry has failed.
+//
+// Parameters:
+//   - cacheService: Cache for provider lookups that outlive weather data, such as NWS grids
 //
 // Returns:
-//   - ports.WeatherClient: NWS client wrapped with circuit breaker
-func (a *App) initWeatherClient() ports.WeatherClient {
+//   - ports.WeatherClient: Failover client over circuit-breaker-protected providers
+//   - error: Unknown, duplicate or missing provider names, or malformed coverage data
+func (a *App) initWeatherClient(cacheService ports.CacheService) (ports.WeatherClient, error) {
 	httpClient := &http.Client{
 		Timeout: a.cfg.External.HTTPTimeout,
 	}
 
-	nwsClient := nws.NewClient(a.cfg.External.NWSBaseURL, httpClient, a.logger)
+	nwsCfg := nws.Config{
+		UserAgent:       a.cfg.External.NWSUserAgent,
+		Contact:         a.cfg.External.NWSContact,
+		Accept:          a.cfg.External.NWSAccept,
+		FeatureFlags:    a.cfg.External.NWSFeatureFlags,
+		PointsBaseURL:   a.cfg.External.NWSPointsBaseURL,
+		AlertsBaseURL:   a.cfg.External.NWSAlertsBaseURL,
+		StationsBaseURL: a.cfg.External.NWSStationsBaseURL,
+		GridCacheTTL:    a.cfg.Cache.GridTTL,
+		Retry: nws.RetryConfig{
+			MaxAttempts:       a.cfg.External.NWSRetryMaxAttempts,
+			BaseDelay:         a.cfg.External.NWSRetryBaseDelay,
+			MaxDelay:          a.cfg.External.NWSRetryMaxDelay,
+			RetryableStatuses: a.cfg.External.NWSRetryStatuses,
+			RetryableErrors:   a.cfg.External.NWSRetryErrors,
+		},
+	}
+
+	if a.telemetry != nil {
+		nwsCfg.Meter = a.telemetry.Meter
+	}
+
+	// registry maps provider names to their constructors
+	registry := map[string]func() ports.WeatherClient{
+		nws.ProviderName: func() ports.WeatherClient {
+			return nws.NewClient(a.cfg.External.NWSBaseURL, httpClient, cacheService, nwsCfg, a.logger)
+		},
+		openmeteo.ProviderName: func() ports.WeatherClient {
+			return openmeteo.NewClient(a.cfg.External.OpenMeteoBaseURL, httpClient, a.userAgent(), a.logger)
+		},
+	}
+
+	coverageMap, err := coverage.Default()
+
+	if err != nil {
+		return nil, err
+	}
+
 	cbManager := circuitbreaker.NewManager(a.logger)
+	providers := make([]failover.Provider, 0, len(a.cfg.External.WeatherProviders))
+	seen := make(map[string]bool)
+
+	for _, name := range a.cfg.External.WeatherProviders {
+		newClient, ok := registry[name]
+
+		if !ok {
+			return nil, fmt.Errorf("unknown weather provider %q", name)
+		}
+
+		if seen[name] {
+			return nil, fmt.Errorf("weather provider %q is listed more than once", name)
+		}
 
-	weatherClient := &CircuitBreakerWeatherClient{
-		client: nwsClient,
-		cb: cbManager.GetBreaker("nws-api", circuitbreaker.Config{
-			MaxRequests: 3,
-			Interval:    10 * time.Second,
-			Timeout:     30 * time.Second,
-		}),
+		seen[name] = true
+
+		provider := failover.Provider{
+			Name: name,
+			Client: &CircuitBreakerWeatherClient{
+				client: newClient(),
+				cb: cbManager.GetBreaker(name+"-api", circuitbreaker.Config{
+					MaxRequests: 3,
+					Interval:    10 * time.Second,
+					Timeout:     30 * time.Second,
+					IsSuccessful: func(err error) bool {
+						return err == nil || errors.Is(err, domain.ErrLocationNotSupported) ||
+							errors.As(err, new(*ports.NotModifiedError))
+					},
+				}),
+			},
+		}
+
+		// Providers without coverage data are assumed to serve every location
+		if area, ok := coverageMap[name]; ok {
+			provider.Coverage = area
+		}
+
+		providers = append(providers, provider)
 	}
 
-	return weatherClient
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("no weather providers configured")
+	}
+
+	a.logger.Info("weather providers configured", zap.Strings("providers", a.cfg.External.WeatherProviders))
+
+	return failover.NewClient(a.logger, providers...), nil
 }
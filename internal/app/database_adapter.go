@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/sean-rowe/weather-service/internal/core/domain"
 	"github.com/sean-rowe/weather-service/internal/core/ports"
 	"github.com/sean-rowe/weather-service/internal/infrastructure/database"
 )
@@ -41,15 +42,25 @@ func (d *DatabaseAdapter) LogAudit(ctx context.Context, log ports.AuditLog) erro
 func (d *DatabaseAdapter) LogWeatherRequest(ctx context.Context, req ports.WeatherRequest) error {
 	// Convert ports.WeatherRequest to database.WeatherRequest
 	dbReq := database.WeatherRequest{
-		RequestID:       req.RequestID,
-		Latitude:        req.Latitude,
-		Longitude:       req.Longitude,
-		Temperature:     req.Temperature,
-		TemperatureUnit: req.TemperatureUnit,
-		Forecast:        req.Forecast,
-		Category:        req.Category,
-		ResponseTimeMs:  req.ResponseTimeMs,
-		CacheHit:        req.CacheHit,
+		RequestID:                req.RequestID,
+		Latitude:                 req.Latitude,
+		Longitude:                req.Longitude,
+		Temperature:              req.Temperature,
+		TemperatureUnit:          req.TemperatureUnit,
+		Forecast:                 req.Forecast,
+		Category:                 req.Category,
+		ResponseTimeMs:           req.ResponseTimeMs,
+		CacheHit:                 req.CacheHit,
+		WindSpeedMin:             req.WindSpeedMin,
+		WindSpeedMax:             req.WindSpeedMax,
+		WindSpeedUnit:            req.WindSpeedUnit,
+		WindDirection:            req.WindDirection,
+		PrecipitationProbability: req.PrecipitationProbability,
+		RelativeHumidity:         req.RelativeHumidity,
+		Dewpoint:                 req.Dewpoint,
+		DewpointUnit:             req.DewpointUnit,
+		Icon:                     req.Icon,
+		DetailedForecast:         req.DetailedForecast,
 	}
 	
 	return d.db.LogWeatherRequest(ctx, dbReq)
@@ -58,4 +69,24 @@ func (d *DatabaseAdapter) LogWeatherRequest(ctx context.Context, req ports.Weath
 // GetRequestStats implements ports.DatabaseRepository
 func (d *DatabaseAdapter) GetRequestStats(ctx context.Context, since time.Time) (map[string]interface{}, error) {
 	return d.db.GetRequestStats(ctx, since)
-}
\ No newline at end of file
+}
+
+// GetPopularLocations implements ports.DatabaseRepository
+func (d *DatabaseAdapter) GetPopularLocations(ctx context.Context, limit int, since time.Time) ([]ports.PopularLocation, error) {
+	rows, err := d.db.GetPopularLocations(ctx, limit, since)
+
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]ports.PopularLocation, 0, len(rows))
+
+	for _, row := range rows {
+		locations = append(locations, ports.PopularLocation{
+			Coordinates:  domain.Coordinates{Latitude: row.Latitude, Longitude: row.Longitude},
+			RequestCount: row.RequestCount,
+		})
+	}
+
+	return locations, nil
+}
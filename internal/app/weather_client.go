@@ -4,16 +4,16 @@ package app
 import (
 	"context"
 
-	"github.com/sean-rowe/weather-service/internal/adapters/secondary/nws"
 	"github.com/sean-rowe/weather-service/internal/core/domain"
 	"github.com/sean-rowe/weather-service/internal/core/ports"
 	"github.com/sean-rowe/weather-service/internal/infrastructure/circuitbreaker"
 )
 
 // CircuitBreakerWeatherClient wraps a weather client with circuit breaker protection
-// to provide fault tolerance for external API calls.
+// to provide fault tolerance for external API calls. Each weather provider gets
+// its own wrapper and breaker so that one provider's outage does not trip another's.
 type CircuitBreakerWeatherClient struct {
-	client *nws.Client
+	client ports.WeatherClient
 	cb     *circuitbreaker.CircuitBreakerWrapper
 }
 
@@ -31,3 +31,82 @@ func (c *CircuitBreakerWeatherClient) GetForecast(ctx context.Context, coords do
 
 	return result, err
 }
+
+// GetForecastPeriods retrieves multi-day forecast periods with circuit breaker protection.
+func (c *CircuitBreakerWeatherClient) GetForecastPeriods(ctx context.Context, coords domain.Coordinates) (*ports.ForecastData, error) {
+	var result *ports.ForecastData
+
+	err := c.cb.Execute(ctx, "get-forecast-periods", func() error {
+		var err error
+		result, err = c.client.GetForecastPeriods(ctx, coords)
+
+		return err
+	})
+
+	return result, err
+}
+
+// GetHourlyForecast retrieves hourly forecast periods with circuit breaker protection.
+func (c *CircuitBreakerWeatherClient) GetHourlyForecast(ctx context.Context, coords domain.Coordinates) (*ports.ForecastData, error) {
+	var result *ports.ForecastData
+
+	err := c.cb.Execute(ctx, "get-hourly-forecast", func() error {
+		var err error
+		result, err = c.client.GetHourlyForecast(ctx, coords)
+
+		return err
+	})
+
+	return result, err
+}
+
+// GetObservation retrieves the latest station observation with circuit breaker protection.
+func (c *CircuitBreakerWeatherClient) GetObservation(ctx context.Context, coords domain.Coordinates) (*ports.ObservationData, error) {
+	var result *ports.ObservationData
+
+	err := c.cb.Execute(ctx, "get-observation", func() error {
+		var err error
+		result, err = c.client.GetObservation(ctx, coords)
+
+		return err
+	})
+
+	return result, err
+}
+
+// GetAlerts retrieves active weather alerts with circuit breaker protection.
+func (c *CircuitBreakerWeatherClient) GetAlerts(ctx context.Context, coords domain.Coordinates) ([]ports.AlertData, error) {
+	var result []ports.AlertData
+
+	err := c.cb.Execute(ctx, "get-alerts", func() error {
+		var err error
+		result, err = c.client.GetAlerts(ctx, coords)
+
+		return err
+	})
+
+	return result, err
+}
+
+// LocationKey resolves the provider-native location key. Keys the client can resolve
+// without calling the provider, such as cached NWS grids, bypass the breaker, so cache
+// entries stay reachable while the breaker is open and the lookups do not count
+// towards it. Only lookups that call the provider run with circuit breaker protection.
+func (c *CircuitBreakerWeatherClient) LocationKey(ctx context.Context, coords domain.Coordinates) (string, error) {
+	if cached, ok := c.client.(ports.CachedLocationKeyer); ok {
+		if key, ok := cached.CachedLocationKey(ctx, coords); ok {
+			return key, nil
+		}
+	}
+
+	var result string
+
+	err := c.cb.Execute(ctx, "location-key", func() error {
+		var err error
+		result, err = c.client.LocationKey(ctx, coords)
+
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,110 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sony/gobreaker"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+	"github.com/sean-rowe/weather-service/internal/core/services"
+	"github.com/sean-rowe/weather-service/internal/infrastructure/cache"
+	"github.com/sean-rowe/weather-service/internal/infrastructure/circuitbreaker"
+)
+
+// gridClient is a weather client whose location keys come from a grid cache, like
+// the NWS client. Forecasts fail once failing is set.
+type gridClient struct {
+	ports.WeatherClient
+
+	// grids holds the cached location keys
+	grids map[domain.Coordinates]string
+
+	// failing makes every provider call fail
+	failing bool
+}
+
+// GetForecastPeriods returns one period, or an error while the client is failing.
+func (c *gridClient) GetForecastPeriods(context.Context, domain.Coordinates) (*ports.ForecastData, error) {
+	if c.failing {
+		return nil, errors.New("NWS API returned status 503")
+	}
+
+	return &ports.ForecastData{
+		Periods:  []ports.PeriodData{{Name: "Today", Temperature: 75, Unit: domain.Fahrenheit}},
+		Provider: "nws",
+	}, nil
+}
+
+// LocationKey resolves the key from the grid cache, or fails as a /points lookup would while failing.
+func (c *gridClient) LocationKey(ctx context.Context, coords domain.Coordinates) (string, error) {
+	if key, ok := c.CachedLocationKey(ctx, coords); ok && !c.failing {
+		return key, nil
+	}
+
+	return "", errors.New("NWS API returned status 503")
+}
+
+// CachedLocationKey returns the cached key, if any.
+func (c *gridClient) CachedLocationKey(_ context.Context, coords domain.Coordinates) (string, bool) {
+	key, ok := c.grids[coords]
+
+	return key, ok
+}
+
+// TestCircuitBreakerWeatherClient_LocationKey tests that cached location keys resolve
+// while the breaker is open, so stale forecasts cached under them are still served.
+func TestCircuitBreakerWeatherClient_LocationKey(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	unknown := domain.Coordinates{Latitude: 41.8781, Longitude: -87.6298}
+
+	grid := &gridClient{grids: map[domain.Coordinates]string{coords: "nws:OKX/33,35"}}
+	breaker := circuitbreaker.NewCircuitBreaker(circuitbreaker.Config{Name: "nws-api", Timeout: time.Minute}, logger)
+	client := &CircuitBreakerWeatherClient{client: grid, cb: breaker}
+
+	service := services.NewWeatherService(client, cache.NewMemoryCache(cache.MemoryConfig{}, logger), nil, services.Config{
+		CacheTTL:         10 * time.Millisecond,
+		ForecastMaxStale: time.Hour,
+	}, logger)
+
+	_, err := service.GetForecast(context.Background(), coords, "")
+	assert.NoError(t, err)
+
+	// Trip the breaker with an NWS outage
+	grid.failing = true
+
+	for range 3 {
+		_, _ = client.GetForecastPeriods(context.Background(), coords)
+	}
+
+	assert.Equal(t, gobreaker.StateOpen, breaker.State())
+
+	t.Run("uncached keys are rejected by the open breaker", func(t *testing.T) {
+		_, err := client.LocationKey(context.Background(), unknown)
+
+		assert.ErrorIs(t, err, gobreaker.ErrOpenState)
+	})
+
+	t.Run("cached keys resolve while the breaker is open", func(t *testing.T) {
+		key, err := client.LocationKey(context.Background(), coords)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "nws:OKX/33,35", key)
+	})
+
+	t.Run("stale forecast is served while the breaker is open", func(t *testing.T) {
+		time.Sleep(20 * time.Millisecond)
+
+		forecast, err := service.GetForecast(context.Background(), coords, "")
+
+		assert.NoError(t, err)
+		assert.True(t, forecast.Stale)
+		assert.Equal(t, "Today", forecast.Periods[0].Name)
+	})
+}
@@ -6,7 +6,10 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
 )
 
 // Config holds all configuration settings for the weather service.
@@ -19,6 +22,11 @@ type Config struct {
 	Observability ObservabilityConfig
 	External      ExternalConfig
 	RateLimit     RateLimitConfig
+	Cache         CacheConfig
+	Categories    CategoryConfig
+	Batch         BatchConfig
+	Geocoding     GeocodingConfig
+	Warmer        WarmerConfig
 }
 
 // ServerConfig contains HTTP server settings and timeouts.
@@ -72,9 +80,30 @@ type ObservabilityConfig struct {
 }
 
 // ExternalConfig contains settings for external API integrations.
+// WeatherProviders lists the weather providers to use by name ("nws",
+// "openmeteo"), highest priority first; later providers serve requests
+// only when earlier ones fail or their circuit breakers are open. The NWSRetry*
+// settings control how transient NWS failures are retried before they count
+// against the NWS circuit breaker. NWSContact is sent with NWSUserAgent so NWS can
+// reach the operator; every other outbound client sends the same User-Agent. The
+// NWS*BaseURL settings point single endpoints at mirrors.
 type ExternalConfig struct {
-	NWSBaseURL  string
-	HTTPTimeout time.Duration
+	NWSBaseURL          string
+	NWSPointsBaseURL    string
+	NWSAlertsBaseURL    string
+	NWSStationsBaseURL  string
+	NWSUserAgent        string
+	NWSContact          string
+	NWSAccept           string
+	NWSFeatureFlags     []string
+	OpenMeteoBaseURL    string
+	WeatherProviders    []string
+	HTTPTimeout         time.Duration
+	NWSRetryMaxAttempts int
+	NWSRetryBaseDelay   time.Duration
+	NWSRetryMaxDelay    time.Duration
+	NWSRetryStatuses    []int
+	NWSRetryErrors      []string
 }
 
 // RateLimitConfig contains rate limiting settings.
@@ -83,6 +112,82 @@ type RateLimitConfig struct {
 	Window time.Duration
 }
 
+// CacheConfig contains cache expiry settings for weather data. The *MaxStale settings
+// bound how long past its TTL each kind of data may be served, flagged as stale, while
+// it is refreshed or while the provider is failing. With DistributedLock, replicas
+// sharing Redis take a lock per cache key so only one fetches from upstream. With
+// L1Enabled, Redis mode keeps a small in-process cache in front of Redis. Namespace
+// prefixes the cache's Redis keys so that clearing the cache leaves other keys alone.
+// Codec and Compression select how cached values are encoded and compressed. The
+// Memory* and L1Max* settings bound the entries and bytes held by in-process caches.
+// UpstreamMinTTL and UpstreamMaxTTL bound the TTLs taken from provider caching headers.
+type CacheConfig struct {
+	Namespace           string
+	WeatherTTL          time.Duration
+	HourlyTTL           time.Duration
+	ObservationTTL      time.Duration
+	AlertsTTL           time.Duration
+	GridTTL             time.Duration
+	WeatherMaxStale     time.Duration
+	ForecastMaxStale    time.Duration
+	HourlyMaxStale      time.Duration
+	ObservationMaxStale time.Duration
+	AlertsMaxStale      time.Duration
+	DistributedLock     bool
+	LockTTL             time.Duration
+	L1Enabled           bool
+	L1TTL               time.Duration
+	L1MaxEntries        int
+	L1MaxBytes          int
+	MemoryMaxEntries    int
+	MemoryMaxBytes      int
+	Codec               string
+	Compression         string
+	CompressionMinBytes int
+	UpstreamMinTTL      time.Duration
+	UpstreamMaxTTL      time.Duration
+}
+
+// CategoryConfig contains temperature categorization profile settings.
+type CategoryConfig struct {
+	ProfilesFile   string
+	DefaultProfile string
+	APIKeyProfiles map[string]string
+}
+
+// GeocodingConfig contains location lookup settings.
+// Provider is "gazetteer" (offline only), "census" (HTTP only: Open-Meteo
+// for ZIP codes and places, Census for street addresses) or "chain"
+// (gazetteer first, then the HTTP geocoders for anything it cannot resolve).
+type GeocodingConfig struct {
+	Provider      string
+	CensusURL     string
+	PlacesURL     string
+	GazetteerFile string
+	CacheTTL      time.Duration
+}
+
+// BatchConfig contains settings for the batch weather endpoint.
+type BatchConfig struct {
+	MaxItems    int
+	Concurrency int
+	ItemCost    float64
+}
+
+// WarmerConfig contains settings for the background cache warmer. Every Interval
+// (plus up to Jitter) it refreshes the cache entries of Locations, or of the TopN
+// locations most requested over Lookback when Locations is empty, making at most
+// MaxRequestsPerMinute upstream requests per minute.
+type WarmerConfig struct {
+	Enabled              bool
+	Interval             time.Duration
+	Jitter               time.Duration
+	TopN                 int
+	Lookback             time.Duration
+	Locations            []domain.Coordinates
+	MaxRequestsPerMinute int
+}
+
 // Load reads configuration from environment variables and returns a Config instance.
 //
 // Returns:
@@ -130,13 +235,79 @@ func Load() *Config {
 			JaegerHost:     getEnv("JAEGER_AGENT_HOST", "localhost"),
 		},
 		External: ExternalConfig{
-			NWSBaseURL:  getEnv("NWS_BASE_URL", "https://api.weather.gov"),
-			HTTPTimeout: 30 * time.Second,
+			NWSBaseURL:          getEnv("NWS_BASE_URL", "https://api.weather.gov"),
+			NWSPointsBaseURL:    getEnv("NWS_POINTS_BASE_URL", ""),
+			NWSAlertsBaseURL:    getEnv("NWS_ALERTS_BASE_URL", ""),
+			NWSStationsBaseURL:  getEnv("NWS_STATIONS_BASE_URL", ""),
+			NWSUserAgent:        getEnv("NWS_USER_AGENT", "WeatherService/1.0"),
+			NWSContact:          getEnv("NWS_CONTACT", ""),
+			NWSAccept:           getEnv("NWS_ACCEPT", "application/geo+json"),
+			NWSFeatureFlags:     getEnvAsList("NWS_FEATURE_FLAGS", nil),
+			OpenMeteoBaseURL:    getEnv("OPEN_METEO_BASE_URL", "https://api.open-meteo.com"),
+			WeatherProviders:    getEnvAsList("WEATHER_PROVIDERS", []string{"nws", "openmeteo"}),
+			HTTPTimeout:         30 * time.Second,
+			NWSRetryMaxAttempts: getEnvAsInt("NWS_RETRY_MAX_ATTEMPTS", 3),
+			NWSRetryBaseDelay:   getEnvAsDuration("NWS_RETRY_BASE_DELAY", 250*time.Millisecond),
+			NWSRetryMaxDelay:    getEnvAsDuration("NWS_RETRY_MAX_DELAY", 5*time.Second),
+			NWSRetryStatuses:    getEnvAsIntList("NWS_RETRY_STATUSES", []int{429, 500, 502, 503, 504}),
+			NWSRetryErrors:      getEnvAsList("NWS_RETRY_ERRORS", []string{"timeout", "connection"}),
 		},
 		RateLimit: RateLimitConfig{
 			RPS:    getEnvAsInt("RATE_LIMIT_RPS", 100),
 			Window: time.Minute,
 		},
+		Cache: CacheConfig{
+			Namespace:           getEnv("CACHE_NAMESPACE", "cache"),
+			WeatherTTL:          getEnvAsDuration("CACHE_TTL", 5*time.Minute),
+			HourlyTTL:           getEnvAsDuration("HOURLY_CACHE_TTL", 15*time.Minute),
+			ObservationTTL:      getEnvAsDuration("OBSERVATION_CACHE_TTL", 5*time.Minute),
+			AlertsTTL:           getEnvAsDuration("ALERTS_CACHE_TTL", time.Minute),
+			GridTTL:             getEnvAsDuration("NWS_GRID_CACHE_TTL", 7*24*time.Hour),
+			WeatherMaxStale:     getEnvAsDuration("WEATHER_MAX_STALE", time.Hour),
+			ForecastMaxStale:    getEnvAsDuration("FORECAST_MAX_STALE", 6*time.Hour),
+			HourlyMaxStale:      getEnvAsDuration("HOURLY_MAX_STALE", 3*time.Hour),
+			ObservationMaxStale: getEnvAsDuration("OBSERVATION_MAX_STALE", 30*time.Minute),
+			AlertsMaxStale:      getEnvAsDuration("ALERTS_MAX_STALE", 5*time.Minute),
+			DistributedLock:     getEnvAsBool("CACHE_DISTRIBUTED_LOCK", false),
+			LockTTL:             getEnvAsDuration("CACHE_LOCK_TTL", 30*time.Second),
+			L1Enabled:           getEnvAsBool("CACHE_L1_ENABLED", true),
+			L1TTL:               getEnvAsDuration("CACHE_L1_TTL", 30*time.Second),
+			L1MaxEntries:        getEnvAsInt("CACHE_L1_MAX_ENTRIES", 10000),
+			L1MaxBytes:          getEnvAsInt("CACHE_L1_MAX_BYTES", 16<<20),
+			MemoryMaxEntries:    getEnvAsInt("CACHE_MEMORY_MAX_ENTRIES", 10000),
+			MemoryMaxBytes:      getEnvAsInt("CACHE_MEMORY_MAX_BYTES", 64<<20),
+			Codec:               getEnv("CACHE_CODEC", "json"),
+			Compression:         getEnv("CACHE_COMPRESSION", "none"),
+			CompressionMinBytes: getEnvAsInt("CACHE_COMPRESSION_MIN_BYTES", 1024),
+			UpstreamMinTTL:      getEnvAsDuration("CACHE_UPSTREAM_MIN_TTL", time.Minute),
+			UpstreamMaxTTL:      getEnvAsDuration("CACHE_UPSTREAM_MAX_TTL", time.Hour),
+		},
+		Categories: CategoryConfig{
+			ProfilesFile:   getEnv("CATEGORY_PROFILES_FILE", ""),
+			DefaultProfile: getEnv("DEFAULT_CATEGORY_PROFILE", "default"),
+			APIKeyProfiles: getEnvAsMap("API_KEY_PROFILES"),
+		},
+		Batch: BatchConfig{
+			MaxItems:    getEnvAsInt("BATCH_MAX_ITEMS", 500),
+			Concurrency: getEnvAsInt("BATCH_CONCURRENCY", 10),
+			ItemCost:    getEnvAsFloat("BATCH_ITEM_COST", 0.2),
+		},
+		Geocoding: GeocodingConfig{
+			Provider:      getEnv("GEOCODER", "chain"),
+			CensusURL:     getEnv("GEOCODER_URL", "https://geocoding.geo.census.gov"),
+			PlacesURL:     getEnv("GEOCODER_PLACES_URL", "https://geocoding-api.open-meteo.com"),
+			GazetteerFile: getEnv("GAZETTEER_FILE", ""),
+			CacheTTL:      getEnvAsDuration("GEOCODE_CACHE_TTL", 24*time.Hour),
+		},
+		Warmer: WarmerConfig{
+			Enabled:              getEnvAsBool("WARMER_ENABLED", false),
+			Interval:             getEnvAsDuration("WARMER_INTERVAL", 4*time.Minute),
+			Jitter:               getEnvAsDuration("WARMER_JITTER", 30*time.Second),
+			TopN:                 getEnvAsInt("WARMER_TOP_N", 50),
+			Lookback:             getEnvAsDuration("WARMER_LOOKBACK", 7*24*time.Hour),
+			Locations:            getEnvAsCoordinates("WARMER_LOCATIONS"),
+			MaxRequestsPerMinute: getEnvAsInt("WARMER_MAX_REQUESTS_PER_MINUTE", 60),
+		},
 	}
 }
 
@@ -174,6 +345,24 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// getEnvAsFloat retrieves an environment variable as a float with a fallback default.
+//
+// Parameters:
+//   - key: Environment variable name
+//   - defaultValue: Value to use if variable is not set or invalid
+//
+// Returns:
+//   - float64: Parsed float value or default
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+
+	return defaultValue
+}
+
 // getEnvAsBool retrieves an environment variable as a boolean with a fallback default.
 //
 // Parameters:
@@ -191,3 +380,135 @@ func getEnvAsBool(key string, defaultValue bool) bool {
 
 	return defaultValue
 }
+
+// getEnvAsDuration retrieves an environment variable as a duration with a fallback default.
+//
+// Parameters:
+//   - key: Environment variable name
+//   - defaultValue: Value to use if variable is not set or invalid
+//
+// Returns:
+//   - time.Duration: Parsed duration value (e.g. "90s", "5m") or default
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if durationValue, err := time.ParseDuration(value); err == nil {
+			return durationValue
+		}
+	}
+
+	return defaultValue
+}
+
+// getEnvAsMap retrieves an environment variable as a map of comma-separated
+// key=value pairs. Malformed pairs are ignored.
+//
+// Parameters:
+//   - key: Environment variable name
+//
+// Returns:
+//   - map[string]string: Parsed pairs, empty if the variable is not set
+func getEnvAsMap(key string) map[string]string {
+	result := make(map[string]string)
+
+	for _, pair := range strings.Split(os.Getenv(key), ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(pair), "=")
+
+		if !ok || k == "" || v == "" {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// getEnvAsList retrieves an environment variable as a comma-separated list with
+// a fallback default. Whitespace around items is trimmed and empty items are dropped.
+//
+// Parameters:
+//   - key: Environment variable name
+//   - defaultValue: Value to use if variable is not set or has no items
+//
+// Returns:
+//   - []string: Parsed items in order, or default
+func getEnvAsList(key string, defaultValue []string) []string {
+	var result []string
+
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+
+	if len(result) == 0 {
+		return defaultValue
+	}
+
+	return result
+}
+
+// getEnvAsIntList retrieves an environment variable as a comma-separated list of
+// integers with a fallback default. Items that are not integers are ignored.
+//
+// Parameters:
+//   - key: Environment variable name
+//   - defaultValue: Value to use if variable is not set or has no valid items
+//
+// Returns:
+//   - []int: Parsed integers in order, or default
+func getEnvAsIntList(key string, defaultValue []int) []int {
+	var result []int
+
+	for _, item := range getEnvAsList(key, nil) {
+		if intValue, err := strconv.Atoi(item); err == nil {
+			result = append(result, intValue)
+		}
+	}
+
+	if len(result) == 0 {
+		return defaultValue
+	}
+
+	return result
+}
+
+// getEnvAsCoordinates retrieves an environment variable as a semicolon-separated
+// list of "lat,lon" pairs. Malformed or out-of-range pairs are ignored.
+//
+// Parameters:
+//   - key: Environment variable name
+//
+// Returns:
+//   - []domain.Coordinates: Parsed coordinates in order, empty if the variable is not set
+func getEnvAsCoordinates(key string) []domain.Coordinates {
+	var result []domain.Coordinates
+
+	for _, pair := range strings.Split(os.Getenv(key), ";") {
+		lat, lon, ok := strings.Cut(strings.TrimSpace(pair), ",")
+
+		if !ok {
+			continue
+		}
+
+		latitude, err := strconv.ParseFloat(strings.TrimSpace(lat), 64)
+
+		if err != nil {
+			continue
+		}
+
+		longitude, err := strconv.ParseFloat(strings.TrimSpace(lon), 64)
+
+		if err != nil {
+			continue
+		}
+
+		coords := domain.Coordinates{Latitude: latitude, Longitude: longitude}
+
+		if coords.Validate() == nil {
+			result = append(result, coords)
+		}
+	}
+
+	return result
+}
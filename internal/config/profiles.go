@@ -0,0 +1,85 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+)
+
+// profileFile is the JSON representation of a categorization profile.
+type profileFile struct {
+	Name  string `json:"name"`
+	Bands []struct {
+		Category  string  `json:"category"`
+		Max       float64 `json:"max"`
+		Inclusive bool    `json:"inclusive"`
+	} `json:"bands"`
+	Adjustments struct {
+		HumidityThreshold float64 `json:"humidityThreshold"`
+		HumidityOffset    float64 `json:"humidityOffset"`
+		WindThresholdMph  float64 `json:"windThresholdMph"`
+		WindOffset        float64 `json:"windOffset"`
+	} `json:"adjustments"`
+	UseApparentTemperature bool `json:"useApparentTemperature"`
+}
+
+// LoadCategoryProfiles reads categorization profiles from a JSON file.
+// The file contains an array of profiles; band maximums are in °F and
+// the last band of each profile is unbounded.
+//
+// Parameters:
+//   - path: Path to the JSON profiles file; empty returns no profiles
+//
+// Returns:
+//   - []domain.CategoryProfile: Validated profiles in file order
+//   - error: File read, JSON decode or profile validation error
+func LoadCategoryProfiles(path string) ([]domain.CategoryProfile, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read category profiles: %w", err)
+	}
+
+	var files []profileFile
+
+	if err := json.Unmarshal(data, &files); err != nil {
+		return nil, fmt.Errorf("failed to parse category profiles: %w", err)
+	}
+
+	profiles := make([]domain.CategoryProfile, 0, len(files))
+
+	for _, f := range files {
+		profile := domain.CategoryProfile{
+			Name: f.Name,
+			Adjustments: domain.CategoryAdjustments{
+				HumidityThreshold: f.Adjustments.HumidityThreshold,
+				HumidityOffset:    f.Adjustments.HumidityOffset,
+				WindThresholdMph:  f.Adjustments.WindThresholdMph,
+				WindOffset:        f.Adjustments.WindOffset,
+			},
+			UseApparentTemperature: f.UseApparentTemperature,
+		}
+
+		for _, b := range f.Bands {
+			profile.Bands = append(profile.Bands, domain.CategoryBand{
+				Category:      domain.TemperatureCategory(b.Category),
+				MaxFahrenheit: b.Max,
+				Inclusive:     b.Inclusive,
+			})
+		}
+
+		if err := profile.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid category profile: %w", err)
+		}
+
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, nil
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AlertSeverity classifies the threat to life or property posed by an alert.
+// Values follow the Common Alerting Protocol (CAP) used by NWS.
+type AlertSeverity string
+
+const (
+	// SeverityExtreme indicates an extraordinary threat to life or property
+	SeverityExtreme AlertSeverity = "Extreme"
+
+	// SeveritySevere indicates a significant threat to life or property
+	SeveritySevere AlertSeverity = "Severe"
+
+	// SeverityModerate indicates a possible threat to life or property
+	SeverityModerate AlertSeverity = "Moderate"
+
+	// SeverityMinor indicates minimal to no known threat to life or property
+	SeverityMinor AlertSeverity = "Minor"
+
+	// SeverityUnknown indicates the severity is not known
+	SeverityUnknown AlertSeverity = "Unknown"
+)
+
+// AlertUrgency describes how soon responsive action should be taken.
+type AlertUrgency string
+
+const (
+	// UrgencyImmediate indicates responsive action should be taken immediately
+	UrgencyImmediate AlertUrgency = "Immediate"
+
+	// UrgencyExpected indicates responsive action should be taken within the next hour
+	UrgencyExpected AlertUrgency = "Expected"
+
+	// UrgencyFuture indicates responsive action should be taken in the near future
+	UrgencyFuture AlertUrgency = "Future"
+
+	// UrgencyPast indicates responsive action is no longer required
+	UrgencyPast AlertUrgency = "Past"
+
+	// UrgencyUnknown indicates the urgency is not known
+	UrgencyUnknown AlertUrgency = "Unknown"
+)
+
+// AlertCertainty describes the confidence in the alerted event.
+type AlertCertainty string
+
+const (
+	// CertaintyObserved indicates the event is determined to have occurred or to be ongoing
+	CertaintyObserved AlertCertainty = "Observed"
+
+	// CertaintyLikely indicates the event is likely (probability > ~50%)
+	CertaintyLikely AlertCertainty = "Likely"
+
+	// CertaintyPossible indicates the event is possible but not likely
+	CertaintyPossible AlertCertainty = "Possible"
+
+	// CertaintyUnlikely indicates the event is not expected to occur
+	CertaintyUnlikely AlertCertainty = "Unlikely"
+
+	// CertaintyUnknown indicates the certainty is not known
+	CertaintyUnknown AlertCertainty = "Unknown"
+)
+
+// Alert represents an active weather watch, warning or advisory affecting a location.
+type Alert struct {
+	// ID is the provider's unique identifier for the alert
+	ID string
+
+	// Event names the type of hazard (e.g. "Heat Advisory", "Tornado Warning")
+	Event string
+
+	// Severity classifies the threat posed by the event
+	Severity AlertSeverity
+
+	// Urgency describes how soon action should be taken
+	Urgency AlertUrgency
+
+	// Certainty describes the confidence in the event
+	Certainty AlertCertainty
+
+	// Headline provides a one-line summary of the alert
+	Headline string
+
+	// Description provides the full alert text
+	Description string
+
+	// Instruction provides recommended protective actions
+	Instruction string
+
+	// AreaDescription names the affected area in human-readable form
+	AreaDescription string
+
+	// AffectedZones lists the identifiers of the affected forecast zones
+	AffectedZones []string
+
+	// Onset marks when the hazard is expected to begin (zero if not specified)
+	Onset time.Time
+
+	// Expires marks when the alert message expires
+	Expires time.Time
+}
+
+// AlertReport represents the set of alerts active for a location at a point in time.
+type AlertReport struct {
+	// ID uniquely identifies this report
+	ID uuid.UUID
+
+	// Coordinates specify the geographic location
+	Coordinates Coordinates
+
+	// Alerts contains the active alerts; empty when there are none
+	Alerts []Alert
+
+	// FetchedAt records when the alerts were retrieved
+	FetchedAt time.Time
+
+	// Stale reports that the alerts are past their cache TTL and are served while they are refreshed
+	Stale bool
+}
@@ -0,0 +1,106 @@
+package domain
+
+import "math"
+
+// Thresholds at which the NWS considers the heat index and wind chill meaningful.
+const (
+	// HeatIndexMinFahrenheit is the lowest temperature for which the heat index is reported
+	HeatIndexMinFahrenheit = 80.0
+
+	// WindChillMaxFahrenheit is the highest temperature for which wind chill is reported
+	WindChillMaxFahrenheit = 50.0
+
+	// WindChillMinMph is the lowest wind speed for which wind chill is reported
+	WindChillMinMph = 3.0
+)
+
+// HeatIndex computes the NWS heat index for a temperature and relative humidity.
+// It follows the NWS algorithm: the simple Steadman approximation is used when
+// its average with the temperature is below 80°F, otherwise the Rothfusz
+// regression with its low- and high-humidity adjustments.
+//
+// Parameters:
+//   - t: Air temperature in any unit
+//   - humidity: Relative humidity in percent (0-100)
+//
+// Returns:
+//   - Temperature: Heat index in the unit of t, rounded to one decimal place
+func HeatIndex(t Temperature, humidity float64) Temperature {
+	f := t.ToFahrenheit().Value
+	rh := humidity
+
+	hi := 0.5 * (f + 61.0 + (f-68.0)*1.2 + rh*0.094)
+
+	if (hi+f)/2 >= 80 {
+		hi = -42.379 +
+			2.04901523*f +
+			10.14333127*rh -
+			0.22475541*f*rh -
+			0.00683783*f*f -
+			0.05481717*rh*rh +
+			0.00122874*f*f*rh +
+			0.00085282*f*rh*rh -
+			0.00000199*f*f*rh*rh
+
+		if rh < 13 && f >= 80 && f <= 112 {
+			hi -= ((13 - rh) / 4) * math.Sqrt((17-math.Abs(f-95))/17)
+		} else if rh > 85 && f >= 80 && f <= 87 {
+			hi += ((rh - 85) / 10) * ((87 - f) / 5)
+		}
+	}
+
+	return fromFahrenheit(hi, t.Unit)
+}
+
+// WindChill computes the NWS wind chill for a temperature and sustained wind speed.
+// The formula is only defined for temperatures at or below 50°F and winds of at
+// least 3 mph; callers should use ApparentTemperature to apply those limits.
+//
+// Parameters:
+//   - t: Air temperature in any unit
+//   - wind: Sustained wind speed in any unit
+//
+// Returns:
+//   - Temperature: Wind chill in the unit of t, rounded to one decimal place
+func WindChill(t Temperature, wind Speed) Temperature {
+	f := t.ToFahrenheit().Value
+	v := math.Pow(wind.Convert(MilesPerHour).Value, 0.16)
+
+	wc := 35.74 + 0.6215*f - 35.75*v + 0.4275*f*v
+
+	return fromFahrenheit(wc, t.Unit)
+}
+
+// ApparentTemperature returns how the conditions feel to a person: wind chill
+// when it is cold and windy, heat index when it is hot and the humidity is
+// known, and the air temperature otherwise.
+//
+// Parameters:
+//   - t: Air temperature in any unit
+//   - humidity: Relative humidity in percent, may be nil
+//   - wind: Sustained wind speed, may be nil
+//
+// Returns:
+//   - Temperature: Apparent temperature in the unit of t
+func ApparentTemperature(t Temperature, humidity *float64, wind *Speed) Temperature {
+	f := t.ToFahrenheit().Value
+
+	if wind != nil && f <= WindChillMaxFahrenheit && wind.Convert(MilesPerHour).Value >= WindChillMinMph {
+		return WindChill(t, *wind)
+	}
+
+	if humidity != nil && f >= HeatIndexMinFahrenheit {
+		return HeatIndex(t, *humidity)
+	}
+
+	return t
+}
+
+// fromFahrenheit converts a Fahrenheit value back to the requested unit and
+// rounds it to one decimal place, the precision of the NWS formulas.
+func fromFahrenheit(f float64, unit TemperatureUnit) Temperature {
+	converted := Temperature{Value: f, Unit: Fahrenheit}.Convert(unit)
+	converted.Value = math.Round(converted.Value*10) / 10
+
+	return converted
+}
@@ -0,0 +1,143 @@
+package domain
+
+// SpeedUnit defines the unit of speed measurement.
+type SpeedUnit string
+
+const (
+	// KilometersPerHour represents speed in kilometers per hour
+	KilometersPerHour SpeedUnit = "km/h"
+
+	// MilesPerHour represents speed in miles per hour
+	MilesPerHour SpeedUnit = "mph"
+
+	// MetersPerSecond represents speed in meters per second
+	MetersPerSecond SpeedUnit = "m/s"
+)
+
+// Speed represents a speed measurement, such as wind speed, with its unit.
+type Speed struct {
+	// Value is the numeric speed measurement
+	Value float64
+
+	// Unit specifies the speed unit
+	Unit SpeedUnit
+}
+
+// speedFactors gives the number of meters per second in one unit of each speed unit.
+var speedFactors = map[SpeedUnit]float64{
+	MetersPerSecond:   1,
+	KilometersPerHour: 1000.0 / 3600.0,
+	MilesPerHour:      0.44704,
+}
+
+// Convert returns the speed expressed in the requested unit.
+// An unrecognized source or target unit returns the speed unchanged.
+func (s Speed) Convert(unit SpeedUnit) Speed {
+	from, okFrom := speedFactors[s.Unit]
+	to, okTo := speedFactors[unit]
+
+	if !okFrom || !okTo {
+		return s
+	}
+
+	return Speed{Value: s.Value * from / to, Unit: unit}
+}
+
+// PressureUnit defines the unit of atmospheric pressure measurement.
+type PressureUnit string
+
+const (
+	// Pascal represents pressure in pascals
+	Pascal PressureUnit = "Pa"
+
+	// Hectopascal represents pressure in hectopascals (equivalent to millibars)
+	Hectopascal PressureUnit = "hPa"
+
+	// InchesOfMercury represents pressure in inches of mercury
+	InchesOfMercury PressureUnit = "inHg"
+)
+
+// Pressure represents an atmospheric pressure measurement with its unit.
+type Pressure struct {
+	// Value is the numeric pressure measurement
+	Value float64
+
+	// Unit specifies the pressure unit
+	Unit PressureUnit
+}
+
+// pressureFactors gives the number of pascals in one unit of each pressure unit.
+var pressureFactors = map[PressureUnit]float64{
+	Pascal:          1,
+	Hectopascal:     100,
+	InchesOfMercury: 3386.389,
+}
+
+// Convert returns the pressure expressed in the requested unit.
+// An unrecognized source or target unit returns the pressure unchanged.
+func (p Pressure) Convert(unit PressureUnit) Pressure {
+	from, okFrom := pressureFactors[p.Unit]
+	to, okTo := pressureFactors[unit]
+
+	if !okFrom || !okTo {
+		return p
+	}
+
+	return Pressure{Value: p.Value * from / to, Unit: unit}
+}
+
+// DistanceUnit defines the unit of distance measurement.
+type DistanceUnit string
+
+const (
+	// Meters represents distance in meters
+	Meters DistanceUnit = "m"
+
+	// Kilometers represents distance in kilometers
+	Kilometers DistanceUnit = "km"
+
+	// Miles represents distance in statute miles
+	Miles DistanceUnit = "mi"
+)
+
+// Distance represents a distance measurement, such as visibility, with its unit.
+type Distance struct {
+	// Value is the numeric distance measurement
+	Value float64
+
+	// Unit specifies the distance unit
+	Unit DistanceUnit
+}
+
+// distanceFactors gives the number of meters in one unit of each distance unit.
+var distanceFactors = map[DistanceUnit]float64{
+	Meters:     1,
+	Kilometers: 1000,
+	Miles:      1609.344,
+}
+
+// Convert returns the distance expressed in the requested unit.
+// An unrecognized source or target unit returns the distance unchanged.
+func (d Distance) Convert(unit DistanceUnit) Distance {
+	from, okFrom := distanceFactors[d.Unit]
+	to, okTo := distanceFactors[unit]
+
+	if !okFrom || !okTo {
+		return d
+	}
+
+	return Distance{Value: d.Value * from / to, Unit: unit}
+}
+
+// Wind describes forecast wind as a speed range and the direction it blows from.
+type Wind struct {
+	// MinSpeed is the lower end of the forecast speed range
+	MinSpeed Speed
+
+	// MaxSpeed is the upper end of the forecast speed range; equal to MinSpeed
+	// when the provider forecasts a single speed
+	MaxSpeed Speed
+
+	// Direction is the compass point the wind blows from (e.g. "NW"); empty if variable
+	Direction string
+}
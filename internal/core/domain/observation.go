@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Observation represents measured (not forecast) conditions reported by
+// a weather observation station near the requested location.
+// Optional measurements are nil when the station did not report them.
+type Observation struct {
+	// ID uniquely identifies this observation report
+	ID uuid.UUID
+
+	// Coordinates specify the requested geographic location
+	Coordinates Coordinates
+
+	// StationID is the identifier of the reporting station (e.g. "KNYC")
+	StationID string
+
+	// StationName is the human-readable station name
+	StationName string
+
+	// StationDistance is the distance from the requested location to the station
+	StationDistance Distance
+
+	// ObservedAt records when the station took the measurement
+	ObservedAt time.Time
+
+	// Description provides a human-readable summary of conditions
+	Description string
+
+	// Temperature is the measured air temperature
+	Temperature Temperature
+
+	// Dewpoint is the measured dewpoint temperature
+	Dewpoint *Temperature
+
+	// RelativeHumidity is the measured relative humidity in percent
+	RelativeHumidity *float64
+
+	// WindSpeed is the measured sustained wind speed
+	WindSpeed *Speed
+
+	// WindDirection is the direction the wind is blowing from, in degrees
+	WindDirection *float64
+
+	// Pressure is the measured barometric pressure
+	Pressure *Pressure
+
+	// Visibility is the measured horizontal visibility
+	Visibility *Distance
+
+	// Category classifies the measured conditions according to Profile
+	Category TemperatureCategory
+
+	// Profile names the categorization profile used to assign Category
+	Profile string
+
+	// Provider names the weather provider that supplied the data (e.g. "nws")
+	Provider string
+
+	// FetchedAt records when this observation was retrieved
+	FetchedAt time.Time
+
+	// Stale reports that the data is past its cache TTL and is served while it is refreshed
+	Stale bool
+}
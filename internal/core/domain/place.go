@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrLocationNotFound is returned by geocoders when no place matches a query.
+var ErrLocationNotFound = errors.New("location not found")
+
+// LocationQuery describes a place to resolve to coordinates.
+// Exactly one form is used: free text, a ZIP code, or a city with its state.
+type LocationQuery struct {
+	// Text is a free-form address, place name or ZIP code (e.g. "Denver, CO")
+	Text string
+
+	// PostalCode is a five-digit US ZIP code
+	PostalCode string
+
+	// City is a place name; requires State
+	City string
+
+	// State is a two-letter state abbreviation or full state name
+	State string
+}
+
+// IsEmpty reports whether no location was requested.
+func (q LocationQuery) IsEmpty() bool {
+	return q.Text == "" && q.PostalCode == "" && q.City == "" && q.State == ""
+}
+
+// Validate checks that exactly one query form is used and that it is complete.
+func (q LocationQuery) Validate() error {
+	forms := 0
+
+	if q.Text != "" {
+		forms++
+	}
+
+	if q.PostalCode != "" {
+		forms++
+	}
+
+	if q.City != "" || q.State != "" {
+		forms++
+	}
+
+	if forms != 1 {
+		return fmt.Errorf("exactly one of text, postal code or city and state is required")
+	}
+
+	if q.PostalCode != "" && !IsPostalCode(q.PostalCode) {
+		return fmt.Errorf("postal code %q must be five digits", q.PostalCode)
+	}
+
+	if (q.City == "") != (q.State == "") {
+		return fmt.Errorf("city and state must be given together")
+	}
+
+	return nil
+}
+
+// String returns a normalized form of the query, suitable for logging and cache keys.
+func (q LocationQuery) String() string {
+	switch {
+	case q.PostalCode != "":
+		return "zip:" + q.PostalCode
+	case q.City != "":
+		return "city:" + strings.ToLower(strings.TrimSpace(q.City)) + "," + strings.ToLower(strings.TrimSpace(q.State))
+	default:
+		return "q:" + strings.ToLower(strings.Join(strings.Fields(q.Text), " "))
+	}
+}
+
+// IsPostalCode reports whether s is a five-digit US ZIP code.
+func IsPostalCode(s string) bool {
+	if len(s) != 5 {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Place is a resolved location.
+type Place struct {
+	// Name is the place or matched address (e.g. "Denver")
+	Name string
+
+	// State is the two-letter state abbreviation
+	State string
+
+	// PostalCode is the ZIP code, if the place resolved to one
+	PostalCode string
+
+	// Coordinates are the coordinates used for weather lookups
+	Coordinates Coordinates
+
+	// Source identifies the geocoder that resolved the place (e.g. "gazetteer")
+	Source string
+}
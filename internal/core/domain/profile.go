@@ -0,0 +1,172 @@
+package domain
+
+import "fmt"
+
+// DefaultProfileName is the name of the built-in categorization profile.
+const DefaultProfileName = "default"
+
+// Additional categories used by finer-grained categorization profiles.
+const (
+	// Freezing indicates temperatures at or below the freezing point
+	Freezing TemperatureCategory = "freezing"
+
+	// Cool indicates temperatures between cold and mild
+	Cool TemperatureCategory = "cool"
+
+	// Mild indicates comfortable, temperate conditions
+	Mild TemperatureCategory = "mild"
+
+	// Warm indicates temperatures between mild and hot
+	Warm TemperatureCategory = "warm"
+
+	// Extreme indicates dangerously high temperatures
+	Extreme TemperatureCategory = "extreme"
+)
+
+// CategoryBand is one temperature range within a categorization profile.
+// Bands are evaluated in ascending order; the first band whose upper bound
+// contains the temperature wins.
+type CategoryBand struct {
+	// Category is the label assigned to temperatures in this band
+	Category TemperatureCategory
+
+	// MaxFahrenheit is the band's upper bound in °F; ignored for the last band
+	MaxFahrenheit float64
+
+	// Inclusive includes temperatures equal to MaxFahrenheit in this band
+	Inclusive bool
+}
+
+// CategoryAdjustments shift the temperature used for categorization based on
+// humidity and wind, so that muggy days read warmer and windy days cooler.
+// A zero threshold disables the corresponding adjustment.
+type CategoryAdjustments struct {
+	// HumidityThreshold is the relative humidity (%) at or above which HumidityOffset applies
+	HumidityThreshold float64
+
+	// HumidityOffset is the number of °F added when humidity meets the threshold
+	HumidityOffset float64
+
+	// WindThresholdMph is the wind speed (mph) at or above which WindOffset applies
+	WindThresholdMph float64
+
+	// WindOffset is the number of °F added (usually negative) when wind meets the threshold
+	WindOffset float64
+}
+
+// CategoryProfile is a named set of temperature bands used to categorize weather.
+type CategoryProfile struct {
+	// Name identifies the profile in requests and responses
+	Name string
+
+	// Bands lists the temperature ranges in ascending order
+	Bands []CategoryBand
+
+	// Adjustments optionally shift the temperature before banding; ignored
+	// when UseApparentTemperature is set
+	Adjustments CategoryAdjustments
+
+	// UseApparentTemperature bands on the heat index or wind chill instead of
+	// the adjusted air temperature
+	UseApparentTemperature bool
+}
+
+// CategoryConditions holds the measurements considered when categorizing.
+// Humidity and wind are optional and only used by profiles with adjustments.
+type CategoryConditions struct {
+	// Temperature is the measured or forecast temperature
+	Temperature Temperature
+
+	// RelativeHumidity is the relative humidity percentage, if known
+	RelativeHumidity *float64
+
+	// WindSpeed is the sustained wind speed, if known
+	WindSpeed *Speed
+}
+
+// DefaultCategoryProfile returns the built-in profile: cold below 50°F,
+// hot above 85°F and moderate in between.
+func DefaultCategoryProfile() CategoryProfile {
+	return CategoryProfile{
+		Name: DefaultProfileName,
+		Bands: []CategoryBand{
+			{Category: Cold, MaxFahrenheit: 50},
+			{Category: Moderate, MaxFahrenheit: 85, Inclusive: true},
+			{Category: Hot},
+		},
+	}
+}
+
+// Validate checks that the profile has a name and at least one band, and that
+// band upper bounds are strictly ascending.
+func (p CategoryProfile) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("profile name is required")
+	}
+
+	if len(p.Bands) == 0 {
+		return fmt.Errorf("profile %q must define at least one band", p.Name)
+	}
+
+	for i, band := range p.Bands {
+		if band.Category == "" {
+			return fmt.Errorf("profile %q band %d has no category", p.Name, i)
+		}
+
+		if i > 0 && i < len(p.Bands)-1 && band.MaxFahrenheit <= p.Bands[i-1].MaxFahrenheit {
+			return fmt.Errorf("profile %q band %q must have a higher maximum than %q",
+				p.Name, band.Category, p.Bands[i-1].Category)
+		}
+	}
+
+	return nil
+}
+
+// Categorize classifies the given conditions using the profile's bands.
+//
+// Parameters:
+//   - c: Temperature and optional humidity and wind
+//
+// Returns:
+//   - TemperatureCategory: Category of the first band containing the adjusted temperature
+func (p CategoryProfile) Categorize(c CategoryConditions) TemperatureCategory {
+	fahrenheit := p.adjustedFahrenheit(c)
+
+	for i, band := range p.Bands {
+		if i == len(p.Bands)-1 {
+			return band.Category
+		}
+
+		if fahrenheit < band.MaxFahrenheit || (band.Inclusive && fahrenheit == band.MaxFahrenheit) {
+			return band.Category
+		}
+	}
+
+	return ""
+}
+
+// adjustedFahrenheit applies the profile's apparent temperature setting or
+// its humidity and wind adjustments. The heat index and wind chill already
+// account for humidity and wind, so adjustments only apply to the air temperature.
+func (p CategoryProfile) adjustedFahrenheit(c CategoryConditions) float64 {
+	if p.UseApparentTemperature {
+		return ApparentTemperature(c.Temperature, c.RelativeHumidity, c.WindSpeed).ToFahrenheit().Value
+	}
+
+	fahrenheit := c.Temperature.ToFahrenheit().Value
+	adj := p.Adjustments
+
+	if adj.HumidityThreshold > 0 && c.RelativeHumidity != nil && *c.RelativeHumidity >= adj.HumidityThreshold {
+		fahrenheit += adj.HumidityOffset
+	}
+
+	if adj.WindThresholdMph > 0 && c.WindSpeed != nil {
+		mph := c.WindSpeed.Convert(MilesPerHour).Value
+
+		if mph >= adj.WindThresholdMph {
+			fahrenheit += adj.WindOffset
+		}
+	}
+
+	return fahrenheit
+}
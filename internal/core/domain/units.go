@@ -0,0 +1,82 @@
+package domain
+
+import "fmt"
+
+// UnitSystem selects a consistent set of units for presenting measurements.
+type UnitSystem string
+
+const (
+	// Metric presents Celsius, km/h, hectopascals and kilometers
+	Metric UnitSystem = "metric"
+
+	// Imperial presents Fahrenheit, mph, inches of mercury and miles
+	Imperial UnitSystem = "imperial"
+
+	// SI presents kelvins, m/s, pascals and meters
+	SI UnitSystem = "si"
+)
+
+// ParseUnitSystem converts a unit system name into a UnitSystem.
+//
+// Parameters:
+//   - s: Unit system name (metric, imperial or si)
+//
+// Returns:
+//   - UnitSystem: The matching unit system
+//   - error: Returns error if the name is not a supported unit system
+func ParseUnitSystem(s string) (UnitSystem, error) {
+	switch u := UnitSystem(s); u {
+	case Metric, Imperial, SI:
+		return u, nil
+	default:
+		return "", fmt.Errorf("unsupported unit system %q", s)
+	}
+}
+
+// TemperatureUnit returns the temperature unit used by the unit system.
+func (u UnitSystem) TemperatureUnit() TemperatureUnit {
+	switch u {
+	case Imperial:
+		return Fahrenheit
+	case SI:
+		return Kelvin
+	default:
+		return Celsius
+	}
+}
+
+// SpeedUnit returns the speed unit used by the unit system.
+func (u UnitSystem) SpeedUnit() SpeedUnit {
+	switch u {
+	case Imperial:
+		return MilesPerHour
+	case SI:
+		return MetersPerSecond
+	default:
+		return KilometersPerHour
+	}
+}
+
+// PressureUnit returns the pressure unit used by the unit system.
+func (u UnitSystem) PressureUnit() PressureUnit {
+	switch u {
+	case Imperial:
+		return InchesOfMercury
+	case SI:
+		return Pascal
+	default:
+		return Hectopascal
+	}
+}
+
+// DistanceUnit returns the distance unit used by the unit system.
+func (u UnitSystem) DistanceUnit() DistanceUnit {
+	switch u {
+	case Imperial:
+		return Miles
+	case SI:
+		return Meters
+	default:
+		return Kilometers
+	}
+}
@@ -4,6 +4,7 @@
 package domain
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -17,12 +18,11 @@ type Temperature struct {
 	// Value is the numeric temperature measurement
 	Value float64
 
-	// Unit specifies whether the temperature is in Celsius or Fahrenheit
+	// Unit specifies whether the temperature is in Celsius, Fahrenheit or Kelvin
 	Unit TemperatureUnit
 }
 
 // TemperatureUnit defines the unit of temperature measurement.
-// Only Celsius and Fahrenheit are supported as they are the most common units.
 type TemperatureUnit string
 
 const (
@@ -31,8 +31,57 @@ const (
 
 	// Fahrenheit represents temperature in Fahrenheit scale
 	Fahrenheit TemperatureUnit = "F"
+
+	// Kelvin represents temperature in the Kelvin (SI) scale
+	Kelvin TemperatureUnit = "K"
 )
 
+// ToCelsius returns the temperature expressed in degrees Celsius.
+// Values with an unrecognized unit are assumed to already be Celsius.
+func (t Temperature) ToCelsius() Temperature {
+	switch t.Unit {
+	case Fahrenheit:
+		return Temperature{Value: (t.Value - 32) * 5 / 9, Unit: Celsius}
+	case Kelvin:
+		return Temperature{Value: t.Value - 273.15, Unit: Celsius}
+	default:
+		return Temperature{Value: t.Value, Unit: Celsius}
+	}
+}
+
+// ToFahrenheit returns the temperature expressed in degrees Fahrenheit.
+func (t Temperature) ToFahrenheit() Temperature {
+	if t.Unit == Fahrenheit {
+		return t
+	}
+
+	return Temperature{Value: t.ToCelsius().Value*9/5 + 32, Unit: Fahrenheit}
+}
+
+// ToKelvin returns the temperature expressed in kelvins.
+func (t Temperature) ToKelvin() Temperature {
+	if t.Unit == Kelvin {
+		return t
+	}
+
+	return Temperature{Value: t.ToCelsius().Value + 273.15, Unit: Kelvin}
+}
+
+// Convert returns the temperature expressed in the requested unit.
+// An unrecognized target unit returns the temperature unchanged.
+func (t Temperature) Convert(unit TemperatureUnit) Temperature {
+	switch unit {
+	case Celsius:
+		return t.ToCelsius()
+	case Fahrenheit:
+		return t.ToFahrenheit()
+	case Kelvin:
+		return t.ToKelvin()
+	default:
+		return t
+	}
+}
+
 // TemperatureCategory classifies temperature into human-readable categories.
 type TemperatureCategory string
 
@@ -88,13 +137,162 @@ type Weather struct {
 	// Forecast provides a human-readable weather description
 	Forecast string
 
-	// Category classifies the temperature as hot, cold, or moderate
+	// DetailedForecast provides the full narrative weather description
+	DetailedForecast string
+
+	// Wind is the forecast wind speed range and direction, if provided
+	Wind *Wind
+
+	// PrecipitationProbability is the chance of precipitation in percent, if provided
+	PrecipitationProbability *float64
+
+	// RelativeHumidity is the forecast relative humidity in percent, if provided
+	RelativeHumidity *float64
+
+	// Dewpoint is the forecast dewpoint, if provided
+	Dewpoint *Temperature
+
+	// FeelsLike is the apparent temperature (heat index or wind chill)
+	FeelsLike *Temperature
+
+	// Icon is the URL of the provider's icon for the conditions
+	Icon string
+
+	// Category classifies the temperature according to Profile
 	Category TemperatureCategory
 
+	// Profile names the categorization profile used to assign Category
+	Profile string
+
+	// Provider names the weather provider that supplied the data (e.g. "nws")
+	Provider string
+
 	// FetchedAt records when this weather data was retrieved
 	FetchedAt time.Time
+
+	// GeneratedAt records when the provider generated the data; zero if not reported
+	GeneratedAt time.Time
+
+	// UpdatedAt records when the provider last updated the forecast; zero if not reported
+	UpdatedAt time.Time
+
+	// Stale reports that the data is past its cache TTL and is served while it is refreshed
+	Stale bool
+
+	// Alerts lists the weather alerts active for the location
+	Alerts []Alert
 }
 
+// ForecastPeriod represents a single named period of a multi-day forecast,
+// such as "Tonight" or "Wednesday". Periods alternate between daytime and
+// overnight and each carries its own temperature classification.
+type ForecastPeriod struct {
+	// Name is the human-readable label assigned by the forecaster
+	Name string
+
+	// StartTime marks the beginning of the period
+	StartTime time.Time
+
+	// EndTime marks the end of the period
+	EndTime time.Time
+
+	// IsDaytime indicates whether the period covers daytime hours
+	IsDaytime bool
+
+	// Temperature is the forecast high for daytime periods or low for overnight periods
+	Temperature Temperature
+
+	// ShortForecast provides a brief weather description
+	ShortForecast string
+
+	// DetailedForecast provides the full narrative weather description
+	DetailedForecast string
+
+	// Wind is the forecast wind speed range and direction, if provided
+	Wind *Wind
+
+	// PrecipitationProbability is the chance of precipitation in percent, if provided
+	PrecipitationProbability *float64
+
+	// RelativeHumidity is the forecast relative humidity in percent, if provided
+	RelativeHumidity *float64
+
+	// Dewpoint is the forecast dewpoint, if provided
+	Dewpoint *Temperature
+
+	// Icon is the URL of the provider's icon for the conditions
+	Icon string
+
+	// Category classifies the period temperature using the selected profile
+	Category TemperatureCategory
+}
+
+// Forecast represents a multi-day forecast for a specific location.
+// It contains every period published by the provider in chronological order.
+type Forecast struct {
+	// ID uniquely identifies this forecast
+	ID uuid.UUID
+
+	// Coordinates specify the geographic location
+	Coordinates Coordinates
+
+	// Periods contains the forecast periods in chronological order
+	Periods []ForecastPeriod
+
+	// Profile names the categorization profile used for period categories
+	Profile string
+
+	// Provider names the weather provider that supplied the data (e.g. "nws")
+	Provider string
+
+	// FetchedAt records when this forecast was retrieved
+	FetchedAt time.Time
+
+	// GeneratedAt records when the provider generated the forecast; zero if not reported
+	GeneratedAt time.Time
+
+	// UpdatedAt records when the provider last updated the forecast; zero if not reported
+	UpdatedAt time.Time
+
+	// Stale reports that the data is past its cache TTL and is served while it is refreshed
+	Stale bool
+}
+
+// HourlyForecast represents an hour-by-hour forecast for a specific location.
+// Each period covers one hour; period names are typically empty.
+type HourlyForecast struct {
+	// ID uniquely identifies this forecast
+	ID uuid.UUID
+
+	// Coordinates specify the geographic location
+	Coordinates Coordinates
+
+	// Periods contains the hourly periods in chronological order
+	Periods []ForecastPeriod
+
+	// Profile names the categorization profile used for period categories
+	Profile string
+
+	// Provider names the weather provider that supplied the data (e.g. "nws")
+	Provider string
+
+	// FetchedAt records when this forecast was retrieved
+	FetchedAt time.Time
+
+	// GeneratedAt records when the provider generated the forecast; zero if not reported
+	GeneratedAt time.Time
+
+	// UpdatedAt records when the provider last updated the forecast; zero if not reported
+	UpdatedAt time.Time
+
+	// Stale reports that the data is past its cache TTL and is served while it is refreshed
+	Stale bool
+}
+
+// ErrLocationNotSupported is returned by weather clients for locations outside
+// the area a provider serves. It is not an upstream failure.
+var ErrLocationNotSupported = errors.New("location not supported")
+
 // WeatherError represents domain-specific errors that can occur during weather operations.
 // It provides structured error information with error codes and optional underlying causes.
 type WeatherError struct {
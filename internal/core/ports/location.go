@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+)
+
+// LocationService defines the primary port for resolving place names to coordinates.
+type LocationService interface {
+	// ResolveLocation resolves an address, ZIP code or city and state to a place.
+	// It returns a WeatherError with code INVALID_LOCATION, LOCATION_NOT_FOUND
+	// or GEOCODING_ERROR if the location cannot be resolved.
+	ResolveLocation(ctx context.Context, query domain.LocationQuery) (*domain.Place, error)
+}
+
+// Geocoder defines the secondary port for geocoding providers.
+// Implementations may be offline lookups or remote HTTP services and can be
+// chained so that cheaper sources are tried first.
+type Geocoder interface {
+	// Geocode resolves a validated location query to a place with coordinates.
+	// It returns an error wrapping domain.ErrLocationNotFound if nothing matches.
+	Geocode(ctx context.Context, query domain.LocationQuery) (*domain.Place, error)
+}
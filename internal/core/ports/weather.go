@@ -12,19 +12,74 @@ import (
 
 // WeatherService defines the primary port for weather operations.
 // This interface represents the core business use cases that the application supports.
+//
+// Methods that categorize temperatures accept a categorization profile name;
+// an empty name selects the configured default profile.
 type WeatherService interface {
 	// GetWeather retrieves weather information for the specified coordinates.
 	// It returns a complete Weather domain object or an error if the operation fails.
-	GetWeather(ctx context.Context, coords domain.Coordinates) (*domain.Weather, error)
+	GetWeather(ctx context.Context, coords domain.Coordinates, profile string) (*domain.Weather, error)
+
+	// GetForecast retrieves the multi-day forecast for the specified coordinates.
+	// It returns every forecast period with its own temperature category.
+	GetForecast(ctx context.Context, coords domain.Coordinates, profile string) (*domain.Forecast, error)
+
+	// GetHourlyForecast retrieves the hour-by-hour forecast for the specified coordinates.
+	// The hours parameter caps the number of periods returned; 0 returns all available.
+	GetHourlyForecast(ctx context.Context, coords domain.Coordinates, hours int, profile string) (*domain.HourlyForecast, error)
+
+	// GetObservation retrieves the latest measured conditions from the nearest
+	// observation station that has recent data.
+	GetObservation(ctx context.Context, coords domain.Coordinates, profile string) (*domain.Observation, error)
+
+	// GetAlerts retrieves the weather alerts currently active for the specified coordinates.
+	// It returns an empty report, not an error, when no alerts are in effect.
+	GetAlerts(ctx context.Context, coords domain.Coordinates) (*domain.AlertReport, error)
+
+	// WarmLocation refreshes the cached current weather, forecast and hourly forecast
+	// for the specified coordinates when they are missing or would go stale within
+	// horizon. It returns how many entries were refreshed from the provider.
+	WarmLocation(ctx context.Context, coords domain.Coordinates, horizon time.Duration) (int, error)
 }
 
 // WeatherClient defines the secondary port for external weather data providers.
 // This interface abstracts the communication with external weather APIs,
-// allowing different implementations (NWS, OpenWeather, etc.) to be used interchangeably.
+// allowing different implementations (NWS, Open-Meteo, etc.) to be used interchangeably.
+// Implementations set the Provider field of the data they return.
 type WeatherClient interface {
 	// GetForecast retrieves raw weather data from an external provider.
 	// It returns basic weather information that needs to be transformed into domain objects.
 	GetForecast(ctx context.Context, coords domain.Coordinates) (*WeatherData, error)
+
+	// GetForecastPeriods retrieves every forecast period published by the provider.
+	// It returns the raw periods in chronological order.
+	GetForecastPeriods(ctx context.Context, coords domain.Coordinates) (*ForecastData, error)
+
+	// GetHourlyForecast retrieves the hour-by-hour forecast published by the provider.
+	// It returns one period per hour in chronological order.
+	GetHourlyForecast(ctx context.Context, coords domain.Coordinates) (*ForecastData, error)
+
+	// GetObservation retrieves the latest observation from the nearest station with recent data.
+	// Implementations fall back to the next-nearest station when a station has no recent report.
+	GetObservation(ctx context.Context, coords domain.Coordinates) (*ObservationData, error)
+
+	// GetAlerts retrieves the active watches, warnings and advisories for a point.
+	// It returns an empty slice when no alerts are in effect.
+	GetAlerts(ctx context.Context, coords domain.Coordinates) ([]AlertData, error)
+
+	// LocationKey identifies the provider-native location that serves the coordinates,
+	// such as an NWS grid cell. Coordinates with the same key receive the same forecast,
+	// so the key is used to share cached forecasts between nearby points.
+	LocationKey(ctx context.Context, coords domain.Coordinates) (string, error)
+}
+
+// CachedLocationKeyer is implemented by weather clients that can resolve some location
+// keys without calling the provider, such as from a cache of earlier lookups. Callers
+// use it to resolve keys while the provider is unavailable.
+type CachedLocationKeyer interface {
+	// CachedLocationKey returns the location key for the coordinates if it can be
+	// resolved without a request to the provider.
+	CachedLocationKey(ctx context.Context, coords domain.Coordinates) (string, bool)
 }
 
 // WeatherData represents raw weather information from external providers.
@@ -38,6 +93,231 @@ type WeatherData struct {
 
 	// Forecast contains the weather description from the provider
 	Forecast string
+
+	// DetailedForecast contains the full narrative weather description
+	DetailedForecast string
+
+	// Wind is the forecast wind speed range and direction, nil if not provided
+	Wind *domain.Wind
+
+	// PrecipitationProbability is the chance of precipitation in percent, nil if not provided
+	PrecipitationProbability *float64
+
+	// RelativeHumidity is the forecast relative humidity in percent, nil if not provided
+	RelativeHumidity *float64
+
+	// Dewpoint is the forecast dewpoint, nil if not provided
+	Dewpoint *domain.Temperature
+
+	// Icon is the URL of the provider's icon for the conditions
+	Icon string
+
+	// Provider names the weather provider that supplied the data (e.g. "nws")
+	Provider string
+
+	// Freshness reports when the provider produced the data and how long it may be cached
+	Freshness Freshness
+}
+
+// ForecastData represents a raw multi-day or hourly forecast from external providers.
+type ForecastData struct {
+	// Periods contains the forecast periods in chronological order
+	Periods []PeriodData
+
+	// Provider names the weather provider that supplied the data (e.g. "nws")
+	Provider string
+
+	// Freshness reports when the provider produced the data and how long it may be cached
+	Freshness Freshness
+}
+
+// Freshness describes how fresh upstream data is, as reported by the provider in its
+// response headers and body. Fields the provider did not report are zero.
+type Freshness struct {
+	// Expires is when the provider's Cache-Control max-age or Expires header says the
+	// response stops being fresh
+	Expires time.Time
+
+	// LastModified is the Last-Modified time of the response
+	LastModified time.Time
+
+	// ETag is the entity tag of the response
+	ETag string
+
+	// GeneratedAt is when the provider generated the response
+	GeneratedAt time.Time
+
+	// UpdatedAt is when the underlying forecast was last updated by forecasters or models
+	UpdatedAt time.Time
+}
+
+// NotModifiedError is returned by a WeatherClient when a request made with the
+// validators from WithValidators was answered 304 Not Modified: the caller's cached
+// copy is still current and no data was downloaded.
+type NotModifiedError struct {
+	// Freshness is the freshness of the 304 response, with the validators that still apply
+	Freshness Freshness
+}
+
+// Error implements the error interface for NotModifiedError.
+func (e *NotModifiedError) Error() string {
+	return "upstream data not modified"
+}
+
+// validatorsKey is the context key for the validators of a cached copy.
+type validatorsKey struct{}
+
+// WithValidators returns a context that asks weather clients to revalidate a cached
+// copy with its ETag and Last-Modified validators. A client that supports conditional
+// requests returns a *NotModifiedError when the copy is still current; other clients
+// ignore the validators.
+//
+// Parameters:
+//   - ctx: Parent context
+//   - validators: Freshness of the cached copy; only ETag and LastModified are used
+//
+// Returns:
+//   - context.Context: Context carrying the validators
+func WithValidators(ctx context.Context, validators Freshness) context.Context {
+	return context.WithValue(ctx, validatorsKey{}, validators)
+}
+
+// ValidatorsFromContext returns the validators set with WithValidators.
+//
+// Parameters:
+//   - ctx: Request context
+//
+// Returns:
+//   - Freshness: Validators of the cached copy
+//   - bool: Whether the context carries an ETag or Last-Modified validator
+func ValidatorsFromContext(ctx context.Context) (Freshness, bool) {
+	validators, _ := ctx.Value(validatorsKey{}).(Freshness)
+
+	return validators, validators.ETag != "" || !validators.LastModified.IsZero()
+}
+
+// PeriodData represents a single raw forecast period from external providers.
+type PeriodData struct {
+	// Name is the human-readable period label, such as "Tonight"
+	Name string
+
+	// StartTime marks the beginning of the period
+	StartTime time.Time
+
+	// EndTime marks the end of the period
+	EndTime time.Time
+
+	// IsDaytime indicates whether the period covers daytime hours
+	IsDaytime bool
+
+	// Temperature is the forecast temperature value
+	Temperature float64
+
+	// Unit specifies whether the temperature is in Celsius or Fahrenheit
+	Unit domain.TemperatureUnit
+
+	// ShortForecast contains the brief weather description
+	ShortForecast string
+
+	// DetailedForecast contains the full narrative weather description
+	DetailedForecast string
+
+	// Wind is the forecast wind speed range and direction, nil if not provided
+	Wind *domain.Wind
+
+	// PrecipitationProbability is the chance of precipitation in percent, nil if not provided
+	PrecipitationProbability *float64
+
+	// RelativeHumidity is the forecast relative humidity in percent, nil if not provided
+	RelativeHumidity *float64
+
+	// Dewpoint is the forecast dewpoint, nil if not provided
+	Dewpoint *domain.Temperature
+
+	// Icon is the URL of the provider's icon for the conditions
+	Icon string
+}
+
+// ObservationData represents raw station observation data from external providers.
+// Optional measurements are nil when the station did not report them.
+type ObservationData struct {
+	// StationID is the identifier of the reporting station
+	StationID string
+
+	// StationName is the human-readable station name
+	StationName string
+
+	// StationDistance is the distance from the requested location to the station
+	StationDistance domain.Distance
+
+	// ObservedAt records when the measurement was taken
+	ObservedAt time.Time
+
+	// Description contains the provider's text summary of conditions
+	Description string
+
+	// Temperature is the measured air temperature
+	Temperature domain.Temperature
+
+	// Dewpoint is the measured dewpoint temperature
+	Dewpoint *domain.Temperature
+
+	// RelativeHumidity is the measured relative humidity in percent
+	RelativeHumidity *float64
+
+	// WindSpeed is the measured sustained wind speed
+	WindSpeed *domain.Speed
+
+	// WindDirection is the direction the wind is blowing from, in degrees
+	WindDirection *float64
+
+	// Pressure is the measured barometric pressure
+	Pressure *domain.Pressure
+
+	// Visibility is the measured horizontal visibility
+	Visibility *domain.Distance
+
+	// Provider names the weather provider that supplied the data (e.g. "nws")
+	Provider string
+}
+
+// AlertData represents a raw weather alert from external providers.
+type AlertData struct {
+	// ID is the provider's unique identifier for the alert
+	ID string
+
+	// Event names the type of hazard
+	Event string
+
+	// Severity classifies the threat posed by the event
+	Severity domain.AlertSeverity
+
+	// Urgency describes how soon action should be taken
+	Urgency domain.AlertUrgency
+
+	// Certainty describes the confidence in the event
+	Certainty domain.AlertCertainty
+
+	// Headline provides a one-line summary
+	Headline string
+
+	// Description provides the full alert text
+	Description string
+
+	// Instruction provides recommended protective actions
+	Instruction string
+
+	// AreaDescription names the affected area
+	AreaDescription string
+
+	// AffectedZones lists the affected forecast zone identifiers
+	AffectedZones []string
+
+	// Onset marks when the hazard is expected to begin (zero if not specified)
+	Onset time.Time
+
+	// Expires marks when the alert message expires
+	Expires time.Time
 }
 
 // CacheService defines the interface for caching weather data.
@@ -55,6 +335,22 @@ type CacheService interface {
 
 	// Clear removes all cached values
 	Clear(ctx context.Context) error
+
+	// DeletePattern removes every cached value whose key matches a glob pattern,
+	// such as "weather:*", and returns how many were removed
+	DeletePattern(ctx context.Context, pattern string) (int64, error)
+}
+
+// LockService defines the interface for short-lived locks shared between service instances.
+// It lets one replica fetch upstream data for a cache key while the others wait for the
+// result to reach the shared cache.
+type LockService interface {
+	// TryLock acquires the named lock for at most ttl without blocking.
+	// It returns a token identifying this holder, or false if another holder has the lock.
+	TryLock(ctx context.Context, key string, ttl time.Duration) (string, bool, error)
+
+	// Unlock releases the lock if it is still held under the given token
+	Unlock(ctx context.Context, key, token string) error
 }
 
 // RateLimitService defines the interface for rate-limiting functionality.
@@ -64,6 +360,11 @@ type RateLimitService interface {
 	// Returns true if allowed, false if the rate limit is exceeded.
 	Allow(ctx context.Context, identifier string, limit int, window time.Duration) (bool, error)
 
+	// AllowN checks if a request costing n units should be allowed and, if so,
+	// consumes all n units at once. Returns false without consuming anything
+	// if the units would exceed the limit.
+	AllowN(ctx context.Context, identifier string, n, limit int, window time.Duration) (bool, error)
+
 	// Reset clears the rate limit counter for the specified identifier
 	Reset(ctx context.Context, identifier string) error
 }
@@ -80,6 +381,18 @@ type DatabaseRepository interface {
 
 	// GetRequestStats retrieves aggregated statistics for monitoring and reporting
 	GetRequestStats(ctx context.Context, since time.Time) (map[string]interface{}, error)
+
+	// GetPopularLocations retrieves the most frequently requested locations since the given time
+	GetPopularLocations(ctx context.Context, limit int, since time.Time) ([]PopularLocation, error)
+}
+
+// PopularLocation is a frequently requested location and how often it was requested.
+type PopularLocation struct {
+	// Coordinates of the requested location
+	Coordinates domain.Coordinates
+
+	// RequestCount is the number of weather requests for the location
+	RequestCount int64
 }
 
 // AuditLog represents a complete audit trail entry for a request.
@@ -147,4 +460,34 @@ type WeatherRequest struct {
 
 	// CacheHit indicates whether the response came from a cache
 	CacheHit bool
+
+	// WindSpeedMin is the lower end of the forecast wind speed, nil if not provided
+	WindSpeedMin *float64
+
+	// WindSpeedMax is the upper end of the forecast wind speed, nil if not provided
+	WindSpeedMax *float64
+
+	// WindSpeedUnit is the unit of WindSpeedMin and WindSpeedMax
+	WindSpeedUnit string
+
+	// WindDirection is the compass point the wind blows from
+	WindDirection string
+
+	// PrecipitationProbability is the chance of precipitation in percent
+	PrecipitationProbability *float64
+
+	// RelativeHumidity is the forecast relative humidity in percent
+	RelativeHumidity *float64
+
+	// Dewpoint is the forecast dewpoint value
+	Dewpoint *float64
+
+	// DewpointUnit specifies the unit of Dewpoint
+	DewpointUnit string
+
+	// Icon is the URL of the provider's condition icon
+	Icon string
+
+	// DetailedForecast is the full narrative forecast that was returned
+	DetailedForecast string
 }
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// sharedFetchTimeout bounds an upstream fetch shared by several callers. The fetch
+// is detached from the caller that started it, so it needs a deadline of its own.
+const sharedFetchTimeout = 30 * time.Second
+
+// lockPollInterval is how often an instance waiting on another instance's fetch
+// checks the cache for the result and retries the lock.
+const lockPollInterval = 100 * time.Millisecond
+
+// flightGroup coalesces concurrent fetches of the same cache key so that only one
+// upstream request per key is in flight on this instance at a time.
+type flightGroup struct {
+	// mu guards flights
+	mu sync.Mutex
+
+	// flights holds the fetch in progress for each key
+	flights map[string]*flight
+}
+
+// flight is a fetch in progress whose result is shared by every caller of the key.
+type flight struct {
+	// done is closed once entry and err are set
+	done chan struct{}
+
+	// entry is the cache entry holding the result of the fetch
+	entry cacheEntry
+
+	// err is the fetch error, if any
+	err error
+}
+
+// do runs fetch for the key unless a fetch for it is already in flight, in which
+// case it waits for that fetch instead. The fetch runs independently of any one
+// caller, so a caller that gives up does not fail the others waiting on it.
+//
+// Parameters:
+//   - ctx: Caller context; when it is done the caller stops waiting
+//   - key: Cache key identifying the data being fetched
+//   - fetch: Retrieves the data and wraps it in a cache entry
+//
+// Returns:
+//   - cacheEntry: Result shared by every caller of the key
+//   - error: Fetch error, or the caller's context error if it stopped waiting
+func (g *flightGroup) do(ctx context.Context, key string, fetch func() (cacheEntry, error)) (cacheEntry, error) {
+	g.mu.Lock()
+
+	if g.flights == nil {
+		g.flights = make(map[string]*flight)
+	}
+
+	f, inFlight := g.flights[key]
+
+	if !inFlight {
+		f = &flight{done: make(chan struct{})}
+		g.flights[key] = f
+
+		go func() {
+			f.entry, f.err = fetch()
+
+			g.mu.Lock()
+			delete(g.flights, key)
+			g.mu.Unlock()
+
+			close(f.done)
+		}()
+	}
+
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.entry, f.err
+	case <-ctx.Done():
+		return cacheEntry{}, ctx.Err()
+	}
+}
+
+// routedValue is fetched data that belongs under a different cache key than the one
+// it was fetched for, such as forecast data served by a fallback provider.
+type routedValue struct {
+	// key is the cache key to store the value under
+	key string
+
+	// value is the fetched data
+	value interface{}
+}
+
+// fetchShared fetches data after a cache miss or for a refresh, caches it, and decodes
+// it into dest unless dest is nil.
+// Concurrent misses for the same key on this instance share one fetch. When a lock
+// service is configured, instances also take a lock per key so that only one replica
+// fetches while the others wait for the result to reach the cache.
+// The fetch carries the validators of any entry still cached for the key; when the
+// provider answers that the data is unchanged, the entry is renewed in place instead
+// of being downloaded and stored again. A fetch that returns a routedValue is stored
+// under the key it names instead of key.
+//
+// Parameters:
+//   - ctx: Caller context; cancelling it stops this caller waiting, not the fetch
+//   - key: Cache key to fetch and store under
+//   - policy: Cache policy for the key; the provider's freshness can shorten or extend its TTL
+//   - dest: Pointer to the value the fetched data is decoded into (can be nil)
+//   - fetch: Retrieves the value from the weather client
+//
+// Returns:
+//   - error: Fetch error, encoding error, or the caller's context error
+func (s *weatherService) fetchShared(ctx context.Context, key string, policy cachePolicy, dest interface{}, fetch fetchFunc) error {
+	entry, err := s.flights.do(ctx, key, func() (cacheEntry, error) {
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), sharedFetchTimeout)
+		defer cancel()
+
+		// A cached copy, even a stale one, is revalidated rather than downloaded again
+		held, hasHeld := s.loadEntry(fetchCtx, key)
+
+		if s.locker != nil {
+			cached, unlock := s.lockOrAwait(fetchCtx, key, held.CreatedAt)
+
+			if cached != nil {
+				return *cached, nil
+			}
+
+			defer unlock()
+		}
+
+		if hasHeld {
+			fetchCtx = ports.WithValidators(fetchCtx, held.validators())
+		}
+
+		value, freshness, err := fetch(fetchCtx)
+
+		var notModified *ports.NotModifiedError
+
+		if errors.As(err, &notModified) && hasHeld {
+			policy := s.upstreamPolicy(policy, notModified.Freshness)
+			entry := held.renewed(policy, notModified.Freshness)
+
+			if err := s.setToCache(fetchCtx, key, entry, policy); err != nil {
+				s.logger.Warn("failed to renew cached data", zap.String("key", key), zap.Error(err))
+			}
+
+			return entry, nil
+		}
+
+		if err != nil {
+			return cacheEntry{}, err
+		}
+
+		storeKey := key
+
+		if routed, ok := value.(routedValue); ok {
+			storeKey, value = routed.key, routed.value
+		}
+
+		policy := s.upstreamPolicy(policy, freshness)
+		entry, err := newCacheEntry(value, s.codec, policy)
+
+		if err != nil {
+			return cacheEntry{}, err
+		}
+
+		entry.ETag, entry.LastModified = freshness.ETag, freshness.LastModified
+
+		if err := s.setToCache(fetchCtx, storeKey, entry, policy); err != nil {
+			s.logger.Warn("failed to cache fetched data", zap.String("key", storeKey), zap.Error(err))
+			// Don't fail the request if caching fails
+		}
+
+		return entry, nil
+	})
+
+	if err != nil || dest == nil {
+		return err
+	}
+
+	return entry.decode(dest)
+}
+
+// lockOrAwait takes the cross-instance lock for a cache key, or waits for the
+// instance holding it to cache a new result or release the lock. A waiter that takes
+// the lock after the holder released it without caching anything fetches itself. If
+// the lock service fails, the caller fetches without the lock.
+//
+// A new result is recognised by its creation time differing from that of the entry
+// cached when the fetch started. The times are only compared for equality, never
+// ordered, so clock skew between instances cannot make an old entry pass for new.
+//
+// Parameters:
+//   - ctx: Context for lock and cache calls; waiting ends when it is done
+//   - key: Cache key being fetched
+//   - seen: Creation time of the entry cached when the fetch started; zero if none
+//
+// Returns:
+//   - *cacheEntry: Entry cached by another instance, or nil if the caller should fetch
+//   - func(): Releases the lock; a no-op if the lock was not acquired
+func (s *weatherService) lockOrAwait(ctx context.Context, key string, seen time.Time) (*cacheEntry, func()) {
+	lockKey := "lock:" + key
+	noop := func() {}
+
+	written := func() (*cacheEntry, bool) {
+		entry, ok := s.loadEntry(ctx, key)
+
+		if !ok || entry.CreatedAt.Equal(seen) {
+			return nil, false
+		}
+
+		return &entry, true
+	}
+
+	poll := time.NewTicker(lockPollInterval)
+	defer poll.Stop()
+
+	for {
+		token, acquired, err := s.locker.TryLock(ctx, lockKey, s.lockTTL)
+
+		if err != nil {
+			s.logger.Warn("failed to acquire fetch lock, fetching without it", zap.String("key", key), zap.Error(err))
+			return nil, noop
+		}
+
+		if acquired {
+			unlock := func() {
+				if err := s.locker.Unlock(ctx, lockKey, token); err != nil {
+					s.logger.Warn("failed to release fetch lock", zap.String("key", key), zap.Error(err))
+				}
+			}
+
+			// Another instance may have cached the data since the fetch started
+			if entry, ok := written(); ok {
+				unlock()
+				return entry, noop
+			}
+
+			return nil, unlock
+		}
+
+		select {
+		case <-poll.C:
+			if entry, ok := written(); ok {
+				return entry, noop
+			}
+		case <-ctx.Done():
+			return nil, noop
+		}
+	}
+}
@@ -0,0 +1,388 @@
+package services
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// cacheSchemaVersion identifies the layout of the domain types stored in the cache.
+// Bump it whenever a cached type (Weather, Forecast, HourlyForecast, Observation or
+// AlertReport) gains, loses or changes a field, so that entries written by older
+// versions are treated as misses instead of decoding into half-populated values.
+const cacheSchemaVersion uint16 = 2
+
+// envelopeMagic starts every cache entry. Its last byte is the layout version of the
+// envelope header itself; entries without it are from an older format.
+var envelopeMagic = [4]byte{'W', 'X', 'C', 2}
+
+// envelopeHeaderSize is the size of the fixed header preceding the ETag and value: the
+// magic, schema version, codec, compression, four timestamps and the ETag length.
+const envelopeHeaderSize = 4 + 2 + 1 + 1 + 4*8 + 2
+
+// defaultCompressionThreshold is the encoded value size above which values are
+// compressed when Config.CacheCompressionThreshold is not set.
+const defaultCompressionThreshold = 1024
+
+// Codec identifiers stored in the envelope. They are persisted, so existing values
+// must never be renumbered.
+const (
+	codecJSON byte = 1
+	codecCBOR byte = 2
+)
+
+// Compression identifiers stored in the envelope. They are persisted, so existing
+// values must never be renumbered.
+const (
+	compressionNone byte = 0
+	compressionGzip byte = 1
+	compressionZstd byte = 2
+)
+
+// codecNames maps the Config.CacheCodec names to codec identifiers.
+var codecNames = map[string]byte{
+	"":     codecJSON,
+	"json": codecJSON,
+	"cbor": codecCBOR,
+}
+
+// compressionNames maps the Config.CacheCompression names to compression identifiers.
+var compressionNames = map[string]byte{
+	"":     compressionNone,
+	"none": compressionNone,
+	"gzip": compressionGzip,
+	"zstd": compressionZstd,
+}
+
+// errEntryFormat is returned for cached data that is not a cache envelope.
+var errEntryFormat = errors.New("cache entry is not a cache envelope")
+
+// errSchemaVersion is returned for cache entries written with a different schema version.
+var errSchemaVersion = errors.New("cache entry schema version mismatch")
+
+// cborMode encodes times with nanosecond precision and their zone offset, as JSON does.
+var cborMode = func() cbor.EncMode {
+	mode, err := cbor.EncOptions{Time: cbor.TimeRFC3339Nano}.EncMode()
+
+	if err != nil {
+		panic(err)
+	}
+
+	return mode
+}()
+
+// zstdEncoder and zstdDecoder are shared by every entry; both are safe for concurrent
+// use and created on first use.
+var (
+	zstdEncoder = sync.OnceValues(func() (*zstd.Encoder, error) { return zstd.NewWriter(nil) })
+	zstdDecoder = sync.OnceValues(func() (*zstd.Decoder, error) { return zstd.NewReader(nil) })
+)
+
+// cacheEntry is the stored form of cached data. It records the schema version and
+// encoding of the value and when it was cached, so that fresh, stale and
+// incompatible reads can be told apart.
+type cacheEntry struct {
+	// Version is the schema version the value was written with
+	Version uint16
+
+	// Codec identifies how Value is encoded
+	Codec byte
+
+	// CreatedAt records when the data was cached
+	CreatedAt time.Time
+
+	// SoftExpiry is when the data stops being fresh and is served as stale
+	SoftExpiry time.Time
+
+	// HardExpiry is when the data is no longer served at all
+	HardExpiry time.Time
+
+	// ETag is the entity tag of the upstream response the value was built from
+	ETag string
+
+	// LastModified is the Last-Modified time of the upstream response the value was built from
+	LastModified time.Time
+
+	// Value holds the cached data, encoded with Codec
+	Value []byte
+}
+
+// newCacheEntry encodes a value into a cache entry that expires according to policy.
+//
+// Parameters:
+//   - value: Data to cache
+//   - codec: Codec identifier to encode the value with
+//   - policy: Cache policy setting the soft and hard expiry
+//
+// Returns:
+//   - cacheEntry: Entry holding the encoded value
+//   - error: Encoding error
+func newCacheEntry(value interface{}, codec byte, policy cachePolicy) (cacheEntry, error) {
+	var data []byte
+	var err error
+
+	switch codec {
+	case codecCBOR:
+		data, err = cborMode.Marshal(value)
+	default:
+		codec = codecJSON
+		data, err = json.Marshal(value)
+	}
+
+	if err != nil {
+		return cacheEntry{}, err
+	}
+
+	now := time.Now()
+
+	return cacheEntry{
+		Version:    cacheSchemaVersion,
+		Codec:      codec,
+		CreatedAt:  now,
+		SoftExpiry: now.Add(policy.ttl),
+		HardExpiry: now.Add(policy.expiry()),
+		Value:      data,
+	}, nil
+}
+
+// validators returns the entry's ETag and Last-Modified validators, with which a
+// refresh asks the provider whether the value changed.
+//
+// Returns:
+//   - ports.Freshness: Freshness holding only the validators
+func (e cacheEntry) validators() ports.Freshness {
+	return ports.Freshness{ETag: e.ETag, LastModified: e.LastModified}
+}
+
+// renewed returns the entry with its value unchanged and its expiry restarted, for a
+// provider that confirmed the value is still current.
+//
+// Parameters:
+//   - policy: Cache policy setting the new soft and hard expiry
+//   - freshness: Freshness of the confirming response; validators it lacks are kept
+//
+// Returns:
+//   - cacheEntry: Renewed entry
+func (e cacheEntry) renewed(policy cachePolicy, freshness ports.Freshness) cacheEntry {
+	now := time.Now()
+
+	e.CreatedAt = now
+	e.SoftExpiry = now.Add(policy.ttl)
+	e.HardExpiry = now.Add(policy.expiry())
+
+	if freshness.ETag != "" {
+		e.ETag = freshness.ETag
+	}
+
+	if !freshness.LastModified.IsZero() {
+		e.LastModified = freshness.LastModified
+	}
+
+	return e
+}
+
+// decode decodes the entry's value into dest using the codec it was written with.
+//
+// Parameters:
+//   - dest: Pointer to the value the cached data is decoded into
+//
+// Returns:
+//   - error: Decoding error, or an error for an unknown codec
+func (e cacheEntry) decode(dest interface{}) error {
+	switch e.Codec {
+	case codecJSON:
+		return json.Unmarshal(e.Value, dest)
+	case codecCBOR:
+		return cbor.Unmarshal(e.Value, dest)
+	default:
+		return fmt.Errorf("unknown cache codec %d", e.Codec)
+	}
+}
+
+// marshal encodes the entry as a fixed binary header followed by its ETag and its
+// value, which is compressed when it is larger than threshold. An ETag too long for
+// the header is dropped.
+//
+// Parameters:
+//   - compression: Compression identifier to use for large values
+//   - threshold: Value size in bytes above which the value is compressed
+//
+// Returns:
+//   - []byte: Encoded envelope
+//   - error: Compression error
+func (e cacheEntry) marshal(compression byte, threshold int) ([]byte, error) {
+	value := e.Value
+
+	if compression == compressionNone || len(value) <= threshold {
+		compression = compressionNone
+	} else {
+		compressed, err := compress(compression, value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		value = compressed
+	}
+
+	etag := e.ETag
+
+	if len(etag) > math.MaxUint16 {
+		etag = ""
+	}
+
+	var lastModified int64
+
+	if !e.LastModified.IsZero() {
+		lastModified = e.LastModified.UnixNano()
+	}
+
+	data := make([]byte, envelopeHeaderSize, envelopeHeaderSize+len(etag)+len(value))
+	copy(data, envelopeMagic[:])
+	binary.BigEndian.PutUint16(data[4:], e.Version)
+	data[6] = e.Codec
+	data[7] = compression
+	binary.BigEndian.PutUint64(data[8:], uint64(e.CreatedAt.UnixNano()))
+	binary.BigEndian.PutUint64(data[16:], uint64(e.SoftExpiry.UnixNano()))
+	binary.BigEndian.PutUint64(data[24:], uint64(e.HardExpiry.UnixNano()))
+	binary.BigEndian.PutUint64(data[32:], uint64(lastModified))
+	binary.BigEndian.PutUint16(data[40:], uint16(len(etag)))
+	data = append(data, etag...)
+
+	return append(data, value...), nil
+}
+
+// unmarshalCacheEntry decodes an envelope written by marshal.
+//
+// Parameters:
+//   - data: Encoded envelope
+//
+// Returns:
+//   - cacheEntry: Entry with its value decompressed
+//   - error: errEntryFormat for data that is not an envelope, errSchemaVersion for
+//     entries written with another schema version, or a decompression error
+func unmarshalCacheEntry(data []byte) (cacheEntry, error) {
+	if len(data) < envelopeHeaderSize || !bytes.Equal(data[:4], envelopeMagic[:]) {
+		return cacheEntry{}, errEntryFormat
+	}
+
+	entry := cacheEntry{
+		Version:    binary.BigEndian.Uint16(data[4:]),
+		Codec:      data[6],
+		CreatedAt:  time.Unix(0, int64(binary.BigEndian.Uint64(data[8:]))),
+		SoftExpiry: time.Unix(0, int64(binary.BigEndian.Uint64(data[16:]))),
+		HardExpiry: time.Unix(0, int64(binary.BigEndian.Uint64(data[24:]))),
+	}
+
+	if lastModified := int64(binary.BigEndian.Uint64(data[32:])); lastModified != 0 {
+		entry.LastModified = time.Unix(0, lastModified)
+	}
+
+	valueStart := envelopeHeaderSize + int(binary.BigEndian.Uint16(data[40:]))
+
+	if len(data) < valueStart {
+		return cacheEntry{}, errEntryFormat
+	}
+
+	entry.ETag = string(data[envelopeHeaderSize:valueStart])
+
+	if entry.Version != cacheSchemaVersion {
+		return entry, errSchemaVersion
+	}
+
+	value, err := decompress(data[7], data[valueStart:])
+
+	if err != nil {
+		return entry, err
+	}
+
+	entry.Value = value
+
+	return entry, nil
+}
+
+// compress compresses data with the given algorithm.
+//
+// Parameters:
+//   - compression: Compression identifier
+//   - data: Data to compress
+//
+// Returns:
+//   - []byte: Compressed data
+//   - error: Compression error, or an error for an unknown algorithm
+func compress(compression byte, data []byte) ([]byte, error) {
+	switch compression {
+	case compressionGzip:
+		var buf bytes.Buffer
+
+		w := gzip.NewWriter(&buf)
+
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	case compressionZstd:
+		encoder, err := zstdEncoder()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return encoder.EncodeAll(data, nil), nil
+	default:
+		return nil, fmt.Errorf("unknown cache compression %d", compression)
+	}
+}
+
+// decompress reverses compress.
+//
+// Parameters:
+//   - compression: Compression identifier stored in the envelope
+//   - data: Data to decompress
+//
+// Returns:
+//   - []byte: Decompressed data
+//   - error: Decompression error, or an error for an unknown algorithm
+func decompress(compression byte, data []byte) ([]byte, error) {
+	switch compression {
+	case compressionNone:
+		return data, nil
+	case compressionGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+
+		if err != nil {
+			return nil, err
+		}
+
+		defer r.Close()
+
+		return io.ReadAll(r)
+	case compressionZstd:
+		decoder, err := zstdDecoder()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return decoder.DecodeAll(data, nil)
+	default:
+		return nil, fmt.Errorf("unknown cache compression %d", compression)
+	}
+}
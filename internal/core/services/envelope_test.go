@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// TestCacheEntry_RoundTrip tests that every codec and compression restores the cached value.
+func TestCacheEntry_RoundTrip(t *testing.T) {
+	zero := 0.0
+	forecast := &domain.Forecast{
+		Periods: []domain.ForecastPeriod{{
+			Name:                     "Tonight",
+			StartTime:                time.Date(2024, 1, 15, 18, 0, 0, 0, time.FixedZone("EST", -5*3600)),
+			PrecipitationProbability: &zero,
+		}},
+		Provider: "nws",
+	}
+	policy := cachePolicy{ttl: time.Minute, maxStale: time.Hour}
+
+	for codecName, codec := range map[string]byte{"json": codecJSON, "cbor": codecCBOR} {
+		for compressionName, compression := range map[string]byte{"none": compressionNone, "gzip": compressionGzip, "zstd": compressionZstd} {
+			t.Run(codecName+"/"+compressionName, func(t *testing.T) {
+				entry, err := newCacheEntry(forecast, codec, policy)
+				assert.NoError(t, err)
+
+				entry.ETag, entry.LastModified = `"v1"`, time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC)
+
+				data, err := entry.marshal(compression, 0)
+				assert.NoError(t, err)
+
+				decoded, err := unmarshalCacheEntry(data)
+				assert.NoError(t, err)
+				assert.Equal(t, cacheSchemaVersion, decoded.Version)
+				assert.Equal(t, codec, decoded.Codec)
+				assert.True(t, entry.CreatedAt.Equal(decoded.CreatedAt))
+				assert.True(t, entry.SoftExpiry.Equal(decoded.SoftExpiry))
+				assert.True(t, entry.HardExpiry.Equal(decoded.HardExpiry))
+				assert.Equal(t, `"v1"`, decoded.ETag)
+				assert.True(t, entry.LastModified.Equal(decoded.LastModified))
+
+				var restored domain.Forecast
+
+				assert.NoError(t, decoded.decode(&restored))
+				assert.Equal(t, "Tonight", restored.Periods[0].Name)
+				assert.True(t, forecast.Periods[0].StartTime.Equal(restored.Periods[0].StartTime))
+				assert.NotNil(t, restored.Periods[0].PrecipitationProbability, "a zero probability is not dropped")
+				assert.Equal(t, "nws", restored.Provider)
+			})
+		}
+	}
+}
+
+// TestCacheEntry_Compression tests that only values above the threshold are compressed.
+func TestCacheEntry_Compression(t *testing.T) {
+	entry, err := newCacheEntry(&domain.AlertReport{}, codecJSON, cachePolicy{ttl: time.Minute})
+	assert.NoError(t, err)
+
+	small, err := entry.marshal(compressionZstd, len(entry.Value))
+	assert.NoError(t, err)
+	assert.Equal(t, compressionNone, small[7])
+
+	large, err := entry.marshal(compressionZstd, len(entry.Value)-1)
+	assert.NoError(t, err)
+	assert.Equal(t, compressionZstd, large[7])
+}
+
+// TestWeatherService_IncompatibleCacheEntries tests that entries from another schema
+// version or an older format are refetched rather than served.
+func TestWeatherService_IncompatibleCacheEntries(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	old := &domain.Forecast{Periods: []domain.ForecastPeriod{{Name: "Yesterday"}}, Provider: "nws"}
+	data := &ports.ForecastData{
+		Periods:  []ports.PeriodData{{Name: "Today", Temperature: 75, Unit: domain.Fahrenheit}},
+		Provider: "nws",
+	}
+
+	otherVersion := cachedEntry(t, time.Now(), cachePolicy{ttl: time.Hour}, old)
+	otherVersion[5]++
+
+	previousLayout := cachedEntry(t, time.Now(), cachePolicy{ttl: time.Hour}, old)
+	previousLayout[3] = 1
+
+	legacy, err := json.Marshal(old)
+	assert.NoError(t, err)
+
+	for name, cached := range map[string][]byte{"schema version mismatch": otherVersion, "previous envelope layout": previousLayout, "legacy JSON entry": legacy} {
+		t.Run(name, func(t *testing.T) {
+			mockClient := new(MockWeatherClient)
+			mockCache := new(MockCacheService)
+			service := NewWeatherService(mockClient, mockCache, nil, Config{CacheCodec: "cbor", CacheCompression: "zstd"}, logger)
+
+			mockCache.On("Get", mock.Anything, mock.Anything).Return(cached, nil)
+			mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			mockClient.On("LocationKey", mock.Anything, coords).Return("nws:OKX/33,35", nil)
+			mockClient.On("GetForecastPeriods", mock.Anything, coords).Return(data, nil).Once()
+
+			forecast, err := service.GetForecast(context.Background(), coords, "")
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Today", forecast.Periods[0].Name)
+			mockClient.AssertExpectations(t)
+		})
+	}
+
+	t.Run("unknown codec or truncated envelope", func(t *testing.T) {
+		entry := cacheEntry{Codec: 9, Value: []byte{0}}
+
+		assert.Error(t, entry.decode(&domain.Forecast{}))
+
+		_, err := unmarshalCacheEntry([]byte("WXC"))
+		assert.ErrorIs(t, err, errEntryFormat)
+	})
+}
@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// locationService implements the LocationService interface by geocoding
+// location queries and caching the resolved places.
+type locationService struct {
+	// geocoder resolves queries to places
+	geocoder ports.Geocoder
+
+	// cache stores resolved places; place coordinates rarely change
+	cache ports.CacheService
+
+	// cacheTTL defines how long resolved places remain valid in cache
+	cacheTTL time.Duration
+
+	// logger records operational events and errors
+	logger *zap.Logger
+}
+
+// LocationConfig holds tunable settings for the location service.
+// Zero values are replaced with sensible defaults.
+type LocationConfig struct {
+	// CacheTTL defines how long resolved places remain valid in cache (default: 24h)
+	CacheTTL time.Duration
+}
+
+// NewLocationService creates a new instance of the location service.
+//
+// Parameters:
+//   - geocoder: Geocoder used to resolve queries
+//   - cache: CacheService interface for caching resolved places
+//   - cfg: Service settings such as the cache TTL
+//   - logger: Zap logger for recording operational events
+//
+// Returns:
+//   - ports.LocationService: Implementation of the LocationService interface
+func NewLocationService(geocoder ports.Geocoder, cache ports.CacheService, cfg LocationConfig, logger *zap.Logger) ports.LocationService {
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = 24 * time.Hour
+	}
+
+	return &locationService{
+		geocoder: geocoder,
+		cache:    cache,
+		cacheTTL: cfg.CacheTTL,
+		logger:   logger,
+	}
+}
+
+// ResolveLocation resolves an address, ZIP code or city and state to a place.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - query: Location query with exactly one form set
+//
+// Returns:
+//   - *domain.Place: Resolved place with the coordinates to use for weather lookups
+//   - error: WeatherError with code INVALID_LOCATION if the query is malformed,
+//     LOCATION_NOT_FOUND if nothing matches, GEOCODING_ERROR if the geocoder fails
+func (s *locationService) ResolveLocation(ctx context.Context, query domain.LocationQuery) (*domain.Place, error) {
+	if err := query.Validate(); err != nil {
+		return nil, &domain.WeatherError{
+			Code:    "INVALID_LOCATION",
+			Message: fmt.Sprintf("Invalid location: %s", err),
+			Cause:   err,
+		}
+	}
+
+	cacheKey := fmt.Sprintf("%x", md5.Sum([]byte("location:"+query.String())))
+
+	if data, err := s.cache.Get(ctx, cacheKey); err == nil {
+		var cached domain.Place
+
+		if err := json.Unmarshal(data, &cached); err == nil {
+			s.logger.Debug("location retrieved from cache", zap.String("query", query.String()))
+
+			return &cached, nil
+		}
+	}
+
+	place, err := s.geocoder.Geocode(ctx, query)
+
+	if err != nil {
+		if errors.Is(err, domain.ErrLocationNotFound) {
+			return nil, &domain.WeatherError{
+				Code:    "LOCATION_NOT_FOUND",
+				Message: "No location matches the query",
+				Cause:   err,
+			}
+		}
+
+		s.logger.Error("failed to geocode location",
+			zap.String("query", query.String()),
+			zap.Error(err),
+		)
+
+		return nil, &domain.WeatherError{
+			Code:    "GEOCODING_ERROR",
+			Message: "Failed to resolve location",
+			Cause:   err,
+		}
+	}
+
+	if err := place.Coordinates.Validate(); err != nil {
+		return nil, &domain.WeatherError{
+			Code:    "GEOCODING_ERROR",
+			Message: "Failed to resolve location",
+			Cause:   err,
+		}
+	}
+
+	if data, err := json.Marshal(place); err == nil {
+		if err := s.cache.Set(ctx, cacheKey, data, s.cacheTTL); err != nil {
+			s.logger.Warn("failed to cache location", zap.Error(err))
+		}
+	}
+
+	s.logger.Debug("location resolved",
+		zap.String("query", query.String()),
+		zap.String("place", place.Name),
+		zap.String("source", place.Source),
+	)
+
+	return place, nil
+}
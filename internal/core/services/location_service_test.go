@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+)
+
+// MockGeocoder is a mock implementation of the Geocoder interface.
+type MockGeocoder struct {
+	mock.Mock
+}
+
+// Geocode mocks the geocoder Geocode method.
+//
+// Parameters:
+//   - ctx: Context for the request
+//   - query: Location query
+//
+// Returns:
+//   - *domain.Place: Mocked place
+//   - error: Mocked error
+func (m *MockGeocoder) Geocode(ctx context.Context, query domain.LocationQuery) (*domain.Place, error) {
+	args := m.Called(ctx, query)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Place), args.Error(1)
+}
+
+// TestLocationService_ResolveLocation tests location resolution and error mapping.
+func TestLocationService_ResolveLocation(t *testing.T) {
+	logger := zap.NewNop()
+	query := domain.LocationQuery{City: "Denver", State: "CO"}
+	denver := &domain.Place{
+		Name:        "Denver",
+		State:       "CO",
+		Coordinates: domain.Coordinates{Latitude: 39.7392, Longitude: -104.9903},
+		Source:      "gazetteer",
+	}
+
+	t.Run("geocodes and caches on miss", func(t *testing.T) {
+		mockGeocoder := new(MockGeocoder)
+		mockCache := new(MockCacheService)
+		service := NewLocationService(mockGeocoder, mockCache, LocationConfig{}, logger)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockGeocoder.On("Geocode", mock.Anything, query).Return(denver, nil)
+
+		place, err := service.ResolveLocation(context.Background(), query)
+
+		assert.NoError(t, err)
+		assert.Equal(t, denver, place)
+		mockCache.AssertCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("cache hit skips the geocoder", func(t *testing.T) {
+		mockGeocoder := new(MockGeocoder)
+		mockCache := new(MockCacheService)
+		service := NewLocationService(mockGeocoder, mockCache, LocationConfig{}, logger)
+		data, _ := json.Marshal(denver)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(data, nil)
+
+		place, err := service.ResolveLocation(context.Background(), query)
+
+		assert.NoError(t, err)
+		assert.Equal(t, denver, place)
+		mockGeocoder.AssertNotCalled(t, "Geocode", mock.Anything, mock.Anything)
+	})
+
+	tests := []struct {
+		name         string
+		query        domain.LocationQuery
+		geocodeErr   error
+		expectedCode string
+	}{
+		{
+			name:         "city without state",
+			query:        domain.LocationQuery{City: "Denver"},
+			expectedCode: "INVALID_LOCATION",
+		},
+		{
+			name:         "several query forms",
+			query:        domain.LocationQuery{Text: "Denver", PostalCode: "80202"},
+			expectedCode: "INVALID_LOCATION",
+		},
+		{
+			name:         "malformed zip code",
+			query:        domain.LocationQuery{PostalCode: "8020A"},
+			expectedCode: "INVALID_LOCATION",
+		},
+		{
+			name:         "no match",
+			query:        query,
+			geocodeErr:   fmt.Errorf("%w: Denver, CO", domain.ErrLocationNotFound),
+			expectedCode: "LOCATION_NOT_FOUND",
+		},
+		{
+			name:         "geocoder failure",
+			query:        query,
+			geocodeErr:   errors.New("connection refused"),
+			expectedCode: "GEOCODING_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGeocoder := new(MockGeocoder)
+			mockCache := new(MockCacheService)
+			service := NewLocationService(mockGeocoder, mockCache, LocationConfig{}, logger)
+
+			mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+			mockGeocoder.On("Geocode", mock.Anything, tt.query).Return(nil, tt.geocodeErr)
+
+			place, err := service.ResolveLocation(context.Background(), tt.query)
+
+			var weatherErr *domain.WeatherError
+
+			assert.Nil(t, place)
+			assert.ErrorAs(t, err, &weatherErr)
+			assert.Equal(t, tt.expectedCode, weatherErr.Code)
+			mockCache.AssertNotCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
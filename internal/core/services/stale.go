@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// cachePolicy controls how long one kind of cached data is served.
+type cachePolicy struct {
+	// ttl defines how long cached data is served as fresh
+	ttl time.Duration
+
+	// maxStale defines how long past ttl cached data may still be served, flagged as
+	// stale, while it is refreshed in the background; zero disables stale serving
+	maxStale time.Duration
+}
+
+// expiry returns how long an entry is kept in the cache: its TTL plus the staleness window.
+//
+// Returns:
+//   - time.Duration: Time-to-live passed to the cache
+func (p cachePolicy) expiry() time.Duration {
+	return p.ttl + p.maxStale
+}
+
+// fetchFunc retrieves data from the weather client, along with the provider's report
+// of how fresh it is.
+type fetchFunc func(ctx context.Context) (interface{}, ports.Freshness, error)
+
+// upstreamPolicy returns the policy to cache freshly fetched data with. When the
+// provider said how long its response stays fresh, that replaces the configured TTL,
+// bounded by the upstream TTL limits, so data is refetched soon after the provider
+// publishes an update instead of on a fixed schedule. The staleness window is kept.
+//
+// Parameters:
+//   - policy: Configured cache policy for the kind of data
+//   - freshness: Freshness reported by the provider
+//
+// Returns:
+//   - cachePolicy: Policy with the upstream TTL, or policy unchanged if the provider gave no expiry
+func (s *weatherService) upstreamPolicy(policy cachePolicy, freshness ports.Freshness) cachePolicy {
+	if freshness.Expires.IsZero() {
+		return policy
+	}
+
+	policy.ttl = min(max(time.Until(freshness.Expires), s.minUpstreamTTL), s.maxUpstreamTTL)
+
+	return policy
+}
+
+// errCacheMiss is returned when no decodable entry of the current schema version is
+// cached for a key.
+var errCacheMiss = errors.New("cache miss")
+
+// errEntryExpired is returned for entries past their hard expiry that the cache has
+// not evicted yet.
+var errEntryExpired = errors.New("cache entry expired")
+
+// revalidate refreshes a stale cache entry in the background. The refresh shares any
+// fetch of the key already in flight, and a failed refresh leaves the stale entry in
+// place, so it keeps being served until its staleness window closes.
+//
+// Parameters:
+//   - ctx: Request context; the refresh outlives its cancellation
+//   - key: Cache key to refresh
+//   - policy: Cache policy for the key
+//   - fetch: Retrieves the value from the weather client
+func (s *weatherService) revalidate(ctx context.Context, key string, policy cachePolicy, fetch fetchFunc) {
+	ctx = context.WithoutCancel(ctx)
+
+	go func() {
+		if err := s.fetchShared(ctx, key, policy, nil, fetch); err != nil {
+			s.logger.Warn("background refresh failed, serving stale data", zap.String("key", key), zap.Error(err))
+		}
+	}()
+}
+
+// loadEntry reads and decodes the cache entry for a key. Entries in an older format
+// or written with another schema version count as misses, so a deploy that changes
+// a cached type refetches instead of serving half-populated data.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - key: Cache key to look up
+//
+// Returns:
+//   - cacheEntry: Decoded entry
+//   - bool: Whether a usable entry was cached
+func (s *weatherService) loadEntry(ctx context.Context, key string) (cacheEntry, bool) {
+	data, err := s.cache.Get(ctx, key)
+
+	if err != nil {
+		return cacheEntry{}, false
+	}
+
+	entry, err := unmarshalCacheEntry(data)
+
+	if err != nil {
+		s.logger.Debug("ignoring unusable cache entry", zap.String("key", key), zap.Error(err))
+		return cacheEntry{}, false
+	}
+
+	return entry, true
+}
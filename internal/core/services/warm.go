@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// WarmLocation refreshes the cached current weather, forecast and hourly forecast for
+// a location when they are missing or would go stale within horizon. Refreshes share
+// any fetch of the same key already in flight.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - coords: Geographic coordinates (latitude and longitude)
+//   - horizon: How far ahead an entry must stay fresh to be left alone
+//
+// Returns:
+//   - int: Number of entries refreshed from the provider
+//   - error: WeatherError with code INVALID_COORDINATES if coordinates are invalid,
+//     otherwise the joined errors of the refreshes that failed
+func (s *weatherService) WarmLocation(ctx context.Context, coords domain.Coordinates, horizon time.Duration) (int, error) {
+	if err := coords.Validate(); err != nil {
+		return 0, &domain.WeatherError{
+			Code:    "INVALID_COORDINATES",
+			Message: "The provided coordinates are invalid",
+			Cause:   err,
+		}
+	}
+
+	targets := []struct {
+		kind   string
+		policy cachePolicy
+		fetch  func(ctx context.Context, coords domain.Coordinates) (interface{}, ports.Freshness, error)
+	}{
+		{kind: "weather", policy: s.weatherCache, fetch: s.fetchWeather},
+		{kind: "forecast", policy: s.forecastCache, fetch: s.fetchForecast},
+		{kind: "hourly", policy: s.hourlyCache, fetch: s.fetchHourly},
+	}
+
+	refreshed := 0
+
+	var errs []error
+
+	for _, target := range targets {
+		cacheKey := s.forecastCacheKey(ctx, target.kind, coords)
+
+		if entry, ok := s.loadEntry(ctx, cacheKey.native); ok && time.Until(entry.SoftExpiry) >= horizon {
+			continue
+		}
+
+		err := s.fetchShared(ctx, cacheKey.native, target.policy, nil, routeByProvider(cacheKey, func(ctx context.Context) (interface{}, ports.Freshness, error) {
+			return target.fetch(ctx, coords)
+		}))
+
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		refreshed++
+	}
+
+	return refreshed, errors.Join(errs...)
+}
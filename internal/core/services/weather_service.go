@@ -6,8 +6,9 @@ package services
 import (
 	"context"
 	"crypto/md5"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -33,8 +34,119 @@ type weatherService struct {
 	// logger records operational events and errors
 	logger *zap.Logger
 
-	// cacheTTL defines how long weather data remains valid in cache
-	cacheTTL time.Duration
+	// weatherCache controls how long current weather is cached and served stale
+	weatherCache cachePolicy
+
+	// forecastCache controls how long multi-day forecasts are cached and served stale
+	forecastCache cachePolicy
+
+	// hourlyCache controls how long hourly forecasts are cached and served stale
+	hourlyCache cachePolicy
+
+	// observationCache controls how long station observations are cached and served stale
+	observationCache cachePolicy
+
+	// alertsCache controls how long active alerts are cached and served stale
+	alertsCache cachePolicy
+
+	// profiles holds the categorization profiles keyed by name
+	profiles map[string]domain.CategoryProfile
+
+	// defaultProfile names the profile used when a request does not select one
+	defaultProfile string
+
+	// flights coalesces concurrent upstream fetches of the same cache key
+	flights flightGroup
+
+	// locker coordinates fetches across instances; nil coalesces within this instance only
+	locker ports.LockService
+
+	// lockTTL bounds how long a fetch lock is held if its holder never releases it
+	lockTTL time.Duration
+
+	// codec identifies how cached values are encoded
+	codec byte
+
+	// compression identifies how large cached values are compressed
+	compression byte
+
+	// compressAbove is the encoded size in bytes above which cached values are compressed
+	compressAbove int
+
+	// minUpstreamTTL is the shortest TTL derived from a provider's caching headers
+	minUpstreamTTL time.Duration
+
+	// maxUpstreamTTL is the longest TTL derived from a provider's caching headers
+	maxUpstreamTTL time.Duration
+}
+
+// Config holds tunable settings for the weather service.
+// Zero values are replaced with sensible defaults.
+type Config struct {
+	// CacheTTL defines how long current weather and forecasts remain valid in cache
+	CacheTTL time.Duration
+
+	// HourlyCacheTTL defines how long hourly forecasts remain valid in cache
+	HourlyCacheTTL time.Duration
+
+	// ObservationCacheTTL defines how long station observations remain valid in cache
+	ObservationCacheTTL time.Duration
+
+	// AlertsCacheTTL defines how long active alerts remain valid in cache.
+	// Kept short so that newly issued warnings surface quickly.
+	AlertsCacheTTL time.Duration
+
+	// WeatherMaxStale bounds how long past CacheTTL current weather may be served,
+	// flagged as stale, while it is refreshed or while the provider is failing
+	// (default: 0, never served stale)
+	WeatherMaxStale time.Duration
+
+	// ForecastMaxStale bounds how long past CacheTTL multi-day forecasts may be served stale
+	ForecastMaxStale time.Duration
+
+	// HourlyMaxStale bounds how long past HourlyCacheTTL hourly forecasts may be served stale
+	HourlyMaxStale time.Duration
+
+	// ObservationMaxStale bounds how long past ObservationCacheTTL observations may be served stale
+	ObservationMaxStale time.Duration
+
+	// AlertsMaxStale bounds how long past AlertsCacheTTL alerts may be served stale
+	AlertsMaxStale time.Duration
+
+	// Profiles lists additional categorization profiles. A profile named
+	// "default" replaces the built-in 50°F/85°F profile.
+	Profiles []domain.CategoryProfile
+
+	// DefaultProfile names the profile used when a request does not select one
+	DefaultProfile string
+
+	// Locker, when set, lets only one instance fetch a cache key from upstream at a
+	// time while the others wait for its result (default: nil, per-instance only)
+	Locker ports.LockService
+
+	// LockTTL bounds how long a fetch lock is held if its holder never releases it
+	// (default and minimum: 30s, the timeout of a shared fetch)
+	LockTTL time.Duration
+
+	// CacheCodec names how cached values are encoded: "json" or the more compact
+	// "cbor" (default: json). Entries are decoded with the codec they were written
+	// with, so the codec can be changed without flushing the cache.
+	CacheCodec string
+
+	// CacheCompression names how cached values larger than CacheCompressionThreshold
+	// are compressed: "none", "gzip" or "zstd" (default: none)
+	CacheCompression string
+
+	// CacheCompressionThreshold is the encoded size in bytes above which cached values
+	// are compressed (default: 1024)
+	CacheCompressionThreshold int
+
+	// MinUpstreamTTL is the shortest TTL taken from a provider's Cache-Control or
+	// Expires headers, which replace CacheTTL and HourlyCacheTTL when present (default: 1m)
+	MinUpstreamTTL time.Duration
+
+	// MaxUpstreamTTL is the longest TTL taken from a provider's caching headers (default: 1h)
+	MaxUpstreamTTL time.Duration
 }
 
 // NewWeatherService creates a new instance of the weather service.
@@ -43,17 +155,104 @@ type weatherService struct {
 //   - client: WeatherClient interface for fetching weather data from external APIs
 //   - cache: CacheService interface for caching weather data
 //   - db: DatabaseService interface for logging and analytics (can be nil)
+//   - cfg: Service settings such as cache TTLs
 //   - logger: Zap logger for recording operational events
 //
 // Returns:
 //   - ports.WeatherService: Implementation of the WeatherService interface
-func NewWeatherService(client ports.WeatherClient, cache ports.CacheService, db ports.DatabaseRepository, logger *zap.Logger) ports.WeatherService {
+func NewWeatherService(client ports.WeatherClient, cache ports.CacheService, db ports.DatabaseRepository, cfg Config, logger *zap.Logger) ports.WeatherService {
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = 5 * time.Minute // Cache weather data for 5 minutes
+	}
+
+	if cfg.HourlyCacheTTL <= 0 {
+		cfg.HourlyCacheTTL = 15 * time.Minute
+	}
+
+	if cfg.ObservationCacheTTL <= 0 {
+		cfg.ObservationCacheTTL = 5 * time.Minute
+	}
+
+	if cfg.AlertsCacheTTL <= 0 {
+		cfg.AlertsCacheTTL = time.Minute
+	}
+
+	// A lock that expired mid-fetch would let another instance fetch the same key
+	cfg.LockTTL = max(cfg.LockTTL, sharedFetchTimeout)
+
+	if cfg.CacheCompressionThreshold <= 0 {
+		cfg.CacheCompressionThreshold = defaultCompressionThreshold
+	}
+
+	if cfg.MinUpstreamTTL <= 0 {
+		cfg.MinUpstreamTTL = time.Minute
+	}
+
+	if cfg.MaxUpstreamTTL <= 0 {
+		cfg.MaxUpstreamTTL = time.Hour
+	}
+
+	if cfg.MaxUpstreamTTL < cfg.MinUpstreamTTL {
+		logger.Warn("maximum upstream cache TTL is below the minimum, using the minimum",
+			zap.Duration("min", cfg.MinUpstreamTTL),
+			zap.Duration("max", cfg.MaxUpstreamTTL),
+		)
+		cfg.MaxUpstreamTTL = cfg.MinUpstreamTTL
+	}
+
+	codec, ok := codecNames[cfg.CacheCodec]
+
+	if !ok {
+		logger.Warn("unknown cache codec, using json", zap.String("codec", cfg.CacheCodec))
+		codec = codecJSON
+	}
+
+	compression, ok := compressionNames[cfg.CacheCompression]
+
+	if !ok {
+		logger.Warn("unknown cache compression, storing values uncompressed",
+			zap.String("compression", cfg.CacheCompression),
+		)
+		compression = compressionNone
+	}
+
+	profiles := map[string]domain.CategoryProfile{
+		domain.DefaultProfileName: domain.DefaultCategoryProfile(),
+	}
+
+	for _, p := range cfg.Profiles {
+		profiles[p.Name] = p
+	}
+
+	if _, ok := profiles[cfg.DefaultProfile]; !ok {
+		if cfg.DefaultProfile != "" {
+			logger.Warn("unknown default categorization profile, using built-in default",
+				zap.String("profile", cfg.DefaultProfile),
+			)
+		}
+
+		cfg.DefaultProfile = domain.DefaultProfileName
+	}
+
 	return &weatherService{
-		client:   client,
-		cache:    cache,
-		db:       db,
-		logger:   logger,
-		cacheTTL: 5 * time.Minute, // Cache weather data for 5 minutes
+		client:           client,
+		cache:            cache,
+		db:               db,
+		logger:           logger,
+		weatherCache:     cachePolicy{ttl: cfg.CacheTTL, maxStale: max(cfg.WeatherMaxStale, 0)},
+		forecastCache:    cachePolicy{ttl: cfg.CacheTTL, maxStale: max(cfg.ForecastMaxStale, 0)},
+		hourlyCache:      cachePolicy{ttl: cfg.HourlyCacheTTL, maxStale: max(cfg.HourlyMaxStale, 0)},
+		observationCache: cachePolicy{ttl: cfg.ObservationCacheTTL, maxStale: max(cfg.ObservationMaxStale, 0)},
+		alertsCache:      cachePolicy{ttl: cfg.AlertsCacheTTL, maxStale: max(cfg.AlertsMaxStale, 0)},
+		profiles:         profiles,
+		defaultProfile:   cfg.DefaultProfile,
+		locker:           cfg.Locker,
+		lockTTL:          cfg.LockTTL,
+		codec:            codec,
+		compression:      compression,
+		compressAbove:    cfg.CacheCompressionThreshold,
+		minUpstreamTTL:   cfg.MinUpstreamTTL,
+		maxUpstreamTTL:   cfg.MaxUpstreamTTL,
 	}
 }
 
@@ -62,12 +261,15 @@ func NewWeatherService(client ports.WeatherClient, cache ports.CacheService, db
 // Parameters:
 //   - ctx: Context for cancellation and timeout control
 //   - coords: Geographic coordinates (latitude and longitude)
+//   - profile: Categorization profile name (empty selects the default)
 //
 // Returns:
-//   - *domain.Weather: Weather data including temperature, forecast, and category
+//   - *domain.Weather: Weather data including temperature, forecast, category and active alerts
 //   - error: WeatherError with code INVALID_COORDINATES if coordinates are invalid,
+//     INVALID_PROFILE if the profile is unknown,
+//     LOCATION_NOT_SUPPORTED if no provider covers the location,
 //     FORECAST_RETRIEVAL_ERROR if external API fails, or other errors
-func (s *weatherService) GetWeather(ctx context.Context, coords domain.Coordinates) (*domain.Weather, error) {
+func (s *weatherService) GetWeather(ctx context.Context, coords domain.Coordinates, profile string) (*domain.Weather, error) {
 	if err := coords.Validate(); err != nil {
 		s.logger.Error("invalid coordinates", zap.Error(err))
 
@@ -78,80 +280,696 @@ func (s *weatherService) GetWeather(ctx context.Context, coords domain.Coordinat
 		}
 	}
 
+	categoryProfile, err := s.resolveProfile(profile)
+
+	if err != nil {
+		return nil, err
+	}
+
 	// Generate cache key
-	cacheKey := s.generateCacheKey(coords)
+	cacheKey := s.forecastCacheKey(ctx, "weather", coords)
+
+	fetch := routeByProvider(cacheKey, func(ctx context.Context) (interface{}, ports.Freshness, error) {
+		return s.fetchWeather(ctx, coords)
+	})
 
 	// Try to get from the cache first
 	cacheHit := false
 	startTime := time.Now()
-	
-	if cached, err := s.getFromCache(ctx, cacheKey); err == nil && cached != nil {
+
+	var cached domain.Weather
+
+	if stale, err := s.getForecastFromCache(ctx, cacheKey, s.weatherCache, &cached, fetch); err == nil {
 		s.logger.Debug("weather data retrieved from cache",
 			zap.Float64("latitude", coords.Latitude),
 			zap.Float64("longitude", coords.Longitude),
 		)
 		
 		cacheHit = true
-		
+		cached.Coordinates = coords
+		cached.Stale = stale
+		cached.FeelsLike = s.feelsLike(cached.Temperature, cached.RelativeHumidity, cached.Wind)
+		cached.Category = s.categorizeTemperature(categoryProfile, cached.Temperature, cached.RelativeHumidity, cached.Wind)
+		cached.Profile = categoryProfile.Name
+		cached.Alerts = s.lookupAlerts(ctx, coords)
+
 		// Log to database if available
 		if s.db != nil {
-			s.logWeatherRequest(ctx, coords, cached, time.Since(startTime), cacheHit)
+			s.logWeatherRequest(ctx, coords, &cached, time.Since(startTime), cacheHit)
 		}
 
-		return cached, nil
+		return &cached, nil
 	}
 
-	// Cache miss - fetch from external API
+	// Cache miss - fetch from external API, sharing the fetch with concurrent misses
+	var weather domain.Weather
+
+	err = s.fetchShared(ctx, cacheKey.native, s.weatherCache, &weather, fetch)
+
+	if err != nil {
+		return nil, err
+	}
+
+	weather.Coordinates = coords
+	weather.FeelsLike = s.feelsLike(weather.Temperature, weather.RelativeHumidity, weather.Wind)
+	weather.Category = s.categorizeTemperature(categoryProfile, weather.Temperature, weather.RelativeHumidity, weather.Wind)
+	weather.Profile = categoryProfile.Name
+
+	// Alerts are attached after caching so they follow their own, shorter TTL
+	weather.Alerts = s.lookupAlerts(ctx, coords)
+
+	s.logger.Info("weather retrieved successfully",
+		zap.Float64("latitude", coords.Latitude),
+		zap.Float64("longitude", coords.Longitude),
+		zap.String("category", string(weather.Category)),
+		zap.String("profile", categoryProfile.Name),
+		zap.String("provider", weather.Provider),
+	)
+
+	// Log to database if available
+	if s.db != nil {
+		s.logWeatherRequest(ctx, coords, &weather, time.Since(startTime), cacheHit)
+	}
+
+	return &weather, nil
+}
+
+// GetForecast retrieves the multi-day forecast for the specified coordinates.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - coords: Geographic coordinates (latitude and longitude)
+//   - profile: Categorization profile name (empty selects the default)
+//
+// Returns:
+//   - *domain.Forecast: Every forecast period, each with its own temperature category
+//   - error: WeatherError with code INVALID_COORDINATES if coordinates are invalid,
+//     INVALID_PROFILE if the profile is unknown, LOCATION_NOT_SUPPORTED if no provider
+//     covers the location, FORECAST_RETRIEVAL_ERROR if external API fails
+func (s *weatherService) GetForecast(ctx context.Context, coords domain.Coordinates, profile string) (*domain.Forecast, error) {
+	if err := coords.Validate(); err != nil {
+		s.logger.Error("invalid coordinates", zap.Error(err))
+
+		return nil, &domain.WeatherError{
+			Code:    "INVALID_COORDINATES",
+			Message: "The provided coordinates are invalid",
+			Cause:   err,
+		}
+	}
+
+	categoryProfile, err := s.resolveProfile(profile)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := s.forecastCacheKey(ctx, "forecast", coords)
+
+	fetch := routeByProvider(cacheKey, func(ctx context.Context) (interface{}, ports.Freshness, error) {
+		return s.fetchForecast(ctx, coords)
+	})
+
+	var cached domain.Forecast
+
+	if stale, err := s.getForecastFromCache(ctx, cacheKey, s.forecastCache, &cached, fetch); err == nil {
+		s.logger.Debug("forecast retrieved from cache",
+			zap.Float64("latitude", coords.Latitude),
+			zap.Float64("longitude", coords.Longitude),
+		)
+
+		cached.Coordinates = coords
+		cached.Stale = stale
+		s.categorizePeriods(categoryProfile, cached.Periods)
+		cached.Profile = categoryProfile.Name
+
+		return &cached, nil
+	}
+
+	var forecast domain.Forecast
+
+	err = s.fetchShared(ctx, cacheKey.native, s.forecastCache, &forecast, fetch)
+
+	if err != nil {
+		return nil, err
+	}
+
+	forecast.Coordinates = coords
+	s.categorizePeriods(categoryProfile, forecast.Periods)
+	forecast.Profile = categoryProfile.Name
+
+	s.logger.Info("forecast retrieved successfully",
+		zap.Float64("latitude", coords.Latitude),
+		zap.Float64("longitude", coords.Longitude),
+		zap.Int("periods", len(forecast.Periods)),
+		zap.String("provider", forecast.Provider),
+	)
+
+	return &forecast, nil
+}
+
+// GetHourlyForecast retrieves the hour-by-hour forecast for the specified coordinates.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - coords: Geographic coordinates (latitude and longitude)
+//   - hours: Maximum number of hourly periods to return (0 or less returns all)
+//   - profile: Categorization profile name (empty selects the default)
+//
+// Returns:
+//   - *domain.HourlyForecast: Hourly periods, each with its own temperature category
+//   - error: WeatherError with code INVALID_COORDINATES if coordinates are invalid,
+//     INVALID_PROFILE if the profile is unknown, LOCATION_NOT_SUPPORTED if no provider
+//     covers the location, FORECAST_RETRIEVAL_ERROR if external API fails
+func (s *weatherService) GetHourlyForecast(ctx context.Context, coords domain.Coordinates, hours int, profile string) (*domain.HourlyForecast, error) {
+	if err := coords.Validate(); err != nil {
+		s.logger.Error("invalid coordinates", zap.Error(err))
+
+		return nil, &domain.WeatherError{
+			Code:    "INVALID_COORDINATES",
+			Message: "The provided coordinates are invalid",
+			Cause:   err,
+		}
+	}
+
+	categoryProfile, err := s.resolveProfile(profile)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := s.forecastCacheKey(ctx, "hourly", coords)
+
+	fetch := routeByProvider(cacheKey, func(ctx context.Context) (interface{}, ports.Freshness, error) {
+		return s.fetchHourly(ctx, coords)
+	})
+
+	var forecast domain.HourlyForecast
+
+	if stale, err := s.getForecastFromCache(ctx, cacheKey, s.hourlyCache, &forecast, fetch); err == nil {
+		s.logger.Debug("hourly forecast retrieved from cache",
+			zap.Float64("latitude", coords.Latitude),
+			zap.Float64("longitude", coords.Longitude),
+		)
+
+		forecast.Coordinates = coords
+		forecast.Stale = stale
+
+		return s.finishHourly(&forecast, hours, categoryProfile), nil
+	}
+
+	// The full window is cached so that any 'hours' value can be served from it
+	err = s.fetchShared(ctx, cacheKey.native, s.hourlyCache, &forecast, fetch)
+
+	if err != nil {
+		return nil, err
+	}
+
+	forecast.Coordinates = coords
+
+	s.logger.Info("hourly forecast retrieved successfully",
+		zap.Float64("latitude", coords.Latitude),
+		zap.Float64("longitude", coords.Longitude),
+		zap.Int("periods", len(forecast.Periods)),
+		zap.String("provider", forecast.Provider),
+	)
+
+	return s.finishHourly(&forecast, hours, categoryProfile), nil
+}
+
+// GetObservation retrieves the latest measured conditions near the specified coordinates.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - coords: Geographic coordinates (latitude and longitude)
+//   - profile: Categorization profile name (empty selects the default)
+//
+// Returns:
+//   - *domain.Observation: Station measurements with station id, distance, timestamp and category
+//   - error: WeatherError with code INVALID_COORDINATES if coordinates are invalid,
+//     INVALID_PROFILE if the profile is unknown,
+//     LOCATION_NOT_SUPPORTED if no provider covers the location,
+//     OBSERVATION_RETRIEVAL_ERROR if no nearby station has recent data
+func (s *weatherService) GetObservation(ctx context.Context, coords domain.Coordinates, profile string) (*domain.Observation, error) {
+	if err := coords.Validate(); err != nil {
+		s.logger.Error("invalid coordinates", zap.Error(err))
+
+		return nil, &domain.WeatherError{
+			Code:    "INVALID_COORDINATES",
+			Message: "The provided coordinates are invalid",
+			Cause:   err,
+		}
+	}
+
+	categoryProfile, err := s.resolveProfile(profile)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := s.generateCacheKey("observation", coords)
+
+	// fetch retrieves the observation from the external API on a cache miss or refresh
+	fetch := func(ctx context.Context) (interface{}, ports.Freshness, error) {
+		data, err := s.client.GetObservation(ctx, coords)
+
+		if err != nil {
+			if errors.Is(err, domain.ErrLocationNotSupported) {
+				return nil, ports.Freshness{}, unsupportedLocation(err)
+			}
+
+			s.logger.Error("failed to get observation",
+				zap.Float64("latitude", coords.Latitude),
+				zap.Float64("longitude", coords.Longitude),
+				zap.Error(err),
+			)
+
+			return nil, ports.Freshness{}, &domain.WeatherError{
+				Code:    "OBSERVATION_RETRIEVAL_ERROR",
+				Message: "Failed to retrieve current observations",
+				Cause:   err,
+			}
+		}
+
+		return &domain.Observation{
+			ID:               uuid.New(),
+			Coordinates:      coords,
+			StationID:        data.StationID,
+			StationName:      data.StationName,
+			StationDistance:  data.StationDistance,
+			ObservedAt:       data.ObservedAt,
+			Description:      data.Description,
+			Temperature:      data.Temperature,
+			Dewpoint:         data.Dewpoint,
+			RelativeHumidity: data.RelativeHumidity,
+			WindSpeed:        data.WindSpeed,
+			WindDirection:    data.WindDirection,
+			Pressure:         data.Pressure,
+			Visibility:       data.Visibility,
+			Provider:         data.Provider,
+			FetchedAt:        time.Now(),
+		}, ports.Freshness{}, nil
+	}
+
+	var cached domain.Observation
+
+	if stale, err := s.getFromCache(ctx, cacheKey, s.observationCache, &cached, fetch); err == nil {
+		s.logger.Debug("observation retrieved from cache",
+			zap.Float64("latitude", coords.Latitude),
+			zap.Float64("longitude", coords.Longitude),
+		)
+
+		cached.Stale = stale
+		s.categorizeObservation(categoryProfile, &cached)
+
+		return &cached, nil
+	}
+
+	var observation domain.Observation
+
+	err = s.fetchShared(ctx, cacheKey, s.observationCache, &observation, fetch)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.categorizeObservation(categoryProfile, &observation)
+
+	s.logger.Info("observation retrieved successfully",
+		zap.Float64("latitude", coords.Latitude),
+		zap.Float64("longitude", coords.Longitude),
+		zap.String("station", observation.StationID),
+		zap.String("provider", observation.Provider),
+	)
+
+	return &observation, nil
+}
+
+// GetAlerts retrieves the weather alerts currently active for the specified coordinates.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - coords: Geographic coordinates (latitude and longitude)
+//
+// Returns:
+//   - *domain.AlertReport: Active alerts, empty when none are in effect
+//   - error: WeatherError with code INVALID_COORDINATES if coordinates are invalid,
+//     LOCATION_NOT_SUPPORTED if no provider publishes alerts for the location,
+//     ALERTS_RETRIEVAL_ERROR if external API fails
+func (s *weatherService) GetAlerts(ctx context.Context, coords domain.Coordinates) (*domain.AlertReport, error) {
+	if err := coords.Validate(); err != nil {
+		s.logger.Error("invalid coordinates", zap.Error(err))
+
+		return nil, &domain.WeatherError{
+			Code:    "INVALID_COORDINATES",
+			Message: "The provided coordinates are invalid",
+			Cause:   err,
+		}
+	}
+
+	cacheKey := s.generateCacheKey("alerts", coords)
+
+	// fetch retrieves the alerts from the external API on a cache miss or refresh
+	fetch := func(ctx context.Context) (interface{}, ports.Freshness, error) {
+		data, err := s.client.GetAlerts(ctx, coords)
+
+		if err != nil {
+			if errors.Is(err, domain.ErrLocationNotSupported) {
+				return nil, ports.Freshness{}, unsupportedLocation(err)
+			}
+
+			s.logger.Error("failed to get alerts",
+				zap.Float64("latitude", coords.Latitude),
+				zap.Float64("longitude", coords.Longitude),
+				zap.Error(err),
+			)
+
+			return nil, ports.Freshness{}, &domain.WeatherError{
+				Code:    "ALERTS_RETRIEVAL_ERROR",
+				Message: "Failed to retrieve weather alerts",
+				Cause:   err,
+			}
+		}
+
+		alerts := make([]domain.Alert, 0, len(data))
+
+		for _, a := range data {
+			alerts = append(alerts, domain.Alert{
+				ID:              a.ID,
+				Event:           a.Event,
+				Severity:        a.Severity,
+				Urgency:         a.Urgency,
+				Certainty:       a.Certainty,
+				Headline:        a.Headline,
+				Description:     a.Description,
+				Instruction:     a.Instruction,
+				AreaDescription: a.AreaDescription,
+				AffectedZones:   a.AffectedZones,
+				Onset:           a.Onset,
+				Expires:         a.Expires,
+			})
+		}
+
+		return &domain.AlertReport{
+			ID:          uuid.New(),
+			Coordinates: coords,
+			Alerts:      alerts,
+			FetchedAt:   time.Now(),
+		}, ports.Freshness{}, nil
+	}
+
+	var cached domain.AlertReport
+
+	if stale, err := s.getFromCache(ctx, cacheKey, s.alertsCache, &cached, fetch); err == nil {
+		s.logger.Debug("alerts retrieved from cache",
+			zap.Float64("latitude", coords.Latitude),
+			zap.Float64("longitude", coords.Longitude),
+		)
+
+		cached.Stale = stale
+
+		return &cached, nil
+	}
+
+	var report domain.AlertReport
+
+	err := s.fetchShared(ctx, cacheKey, s.alertsCache, &report, fetch)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("alerts retrieved successfully",
+		zap.Float64("latitude", coords.Latitude),
+		zap.Float64("longitude", coords.Longitude),
+		zap.Int("alerts", len(report.Alerts)),
+	)
+
+	return &report, nil
+}
+
+// fetchWeather retrieves current weather from the weather client.
+//
+// Parameters:
+//   - ctx: Context for the upstream request
+//   - coords: Geographic coordinates (latitude and longitude)
+//
+// Returns:
+//   - interface{}: *domain.Weather without request-specific fields
+//   - ports.Freshness: Freshness reported by the provider
+//   - error: WeatherError with code LOCATION_NOT_SUPPORTED or FORECAST_RETRIEVAL_ERROR,
+//     or the client's *ports.NotModifiedError
+func (s *weatherService) fetchWeather(ctx context.Context, coords domain.Coordinates) (interface{}, ports.Freshness, error) {
 	data, err := s.client.GetForecast(ctx, coords)
 
 	if err != nil {
+		// A 304 is handled by fetchShared, which renews the cached entry
+		if errors.As(err, new(*ports.NotModifiedError)) {
+			return nil, ports.Freshness{}, err
+		}
+
+		if errors.Is(err, domain.ErrLocationNotSupported) {
+			return nil, ports.Freshness{}, unsupportedLocation(err)
+		}
+
 		s.logger.Error("failed to get forecast",
 			zap.Float64("latitude", coords.Latitude),
 			zap.Float64("longitude", coords.Longitude),
 			zap.Error(err),
 		)
 
-		return nil, &domain.WeatherError{
+		return nil, ports.Freshness{}, &domain.WeatherError{
 			Code:    "FORECAST_RETRIEVAL_ERROR",
 			Message: "Failed to retrieve weather forecast",
 			Cause:   err,
 		}
 	}
 
-	temperature := domain.Temperature{
-		Value: data.Temperature,
-		Unit:  data.Unit,
+	return &domain.Weather{
+		ID:          uuid.New(),
+		Coordinates: coords,
+		Temperature: domain.Temperature{
+			Value: data.Temperature,
+			Unit:  data.Unit,
+		},
+		Forecast:                 data.Forecast,
+		DetailedForecast:         data.DetailedForecast,
+		Wind:                     data.Wind,
+		PrecipitationProbability: data.PrecipitationProbability,
+		RelativeHumidity:         data.RelativeHumidity,
+		Dewpoint:                 data.Dewpoint,
+		Icon:                     data.Icon,
+		Provider:                 data.Provider,
+		FetchedAt:                time.Now(),
+		GeneratedAt:              data.Freshness.GeneratedAt,
+		UpdatedAt:                data.Freshness.UpdatedAt,
+	}, data.Freshness, nil
+}
+
+// fetchForecast retrieves the multi-day forecast from the weather client.
+//
+// Parameters:
+//   - ctx: Context for the upstream request
+//   - coords: Geographic coordinates (latitude and longitude)
+//
+// Returns:
+//   - interface{}: *domain.Forecast with uncategorized periods
+//   - ports.Freshness: Freshness reported by the provider
+//   - error: WeatherError with code LOCATION_NOT_SUPPORTED or FORECAST_RETRIEVAL_ERROR,
+//     or the client's *ports.NotModifiedError
+func (s *weatherService) fetchForecast(ctx context.Context, coords domain.Coordinates) (interface{}, ports.Freshness, error) {
+	data, err := s.client.GetForecastPeriods(ctx, coords)
+
+	if err != nil {
+		// A 304 is handled by fetchShared, which renews the cached entry
+		if errors.As(err, new(*ports.NotModifiedError)) {
+			return nil, ports.Freshness{}, err
+		}
+
+		if errors.Is(err, domain.ErrLocationNotSupported) {
+			return nil, ports.Freshness{}, unsupportedLocation(err)
+		}
+
+		s.logger.Error("failed to get forecast periods",
+			zap.Float64("latitude", coords.Latitude),
+			zap.Float64("longitude", coords.Longitude),
+			zap.Error(err),
+		)
+
+		return nil, ports.Freshness{}, &domain.WeatherError{
+			Code:    "FORECAST_RETRIEVAL_ERROR",
+			Message: "Failed to retrieve weather forecast",
+			Cause:   err,
+		}
 	}
 
-	category := s.categorizeTemperature(temperature)
+	return &domain.Forecast{
+		ID:          uuid.New(),
+		Coordinates: coords,
+		Periods:     s.buildPeriods(data.Periods),
+		Provider:    data.Provider,
+		FetchedAt:   time.Now(),
+		GeneratedAt: data.Freshness.GeneratedAt,
+		UpdatedAt:   data.Freshness.UpdatedAt,
+	}, data.Freshness, nil
+}
+
+// fetchHourly retrieves the full hourly forecast window from the weather client.
+//
+// Parameters:
+//   - ctx: Context for the upstream request
+//   - coords: Geographic coordinates (latitude and longitude)
+//
+// Returns:
+//   - interface{}: *domain.HourlyForecast with uncategorized periods
+//   - ports.Freshness: Freshness reported by the provider
+//   - error: WeatherError with code LOCATION_NOT_SUPPORTED or FORECAST_RETRIEVAL_ERROR,
+//     or the client's *ports.NotModifiedError
+func (s *weatherService) fetchHourly(ctx context.Context, coords domain.Coordinates) (interface{}, ports.Freshness, error) {
+	data, err := s.client.GetHourlyForecast(ctx, coords)
 
-	weather := &domain.Weather{
+	if err != nil {
+		// A 304 is handled by fetchShared, which renews the cached entry
+		if errors.As(err, new(*ports.NotModifiedError)) {
+			return nil, ports.Freshness{}, err
+		}
+
+		if errors.Is(err, domain.ErrLocationNotSupported) {
+			return nil, ports.Freshness{}, unsupportedLocation(err)
+		}
+
+		s.logger.Error("failed to get hourly forecast",
+			zap.Float64("latitude", coords.Latitude),
+			zap.Float64("longitude", coords.Longitude),
+			zap.Error(err),
+		)
+
+		return nil, ports.Freshness{}, &domain.WeatherError{
+			Code:    "FORECAST_RETRIEVAL_ERROR",
+			Message: "Failed to retrieve hourly forecast",
+			Cause:   err,
+		}
+	}
+
+	return &domain.HourlyForecast{
 		ID:          uuid.New(),
 		Coordinates: coords,
-		Temperature: temperature,
-		Forecast:    data.Forecast,
-		Category:    category,
+		Periods:     s.buildPeriods(data.Periods),
+		Provider:    data.Provider,
 		FetchedAt:   time.Now(),
+		GeneratedAt: data.Freshness.GeneratedAt,
+		UpdatedAt:   data.Freshness.UpdatedAt,
+	}, data.Freshness, nil
+}
+
+// unsupportedLocation reports that no weather provider serves the requested location.
+//
+// Parameters:
+//   - err: Weather client error wrapping domain.ErrLocationNotSupported
+//
+// Returns:
+//   - error: WeatherError with code LOCATION_NOT_SUPPORTED
+func unsupportedLocation(err error) error {
+	return &domain.WeatherError{
+		Code:    "LOCATION_NOT_SUPPORTED",
+		Message: "Weather data is not available for the requested location",
+		Cause:   err,
 	}
+}
+
+// lookupAlerts returns the active alerts for a location to attach to a weather response.
+// Alert failures are logged but never fail the surrounding weather request.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - coords: Geographic coordinates to look up
+//
+// Returns:
+//   - []domain.Alert: Active alerts, or nil if they could not be retrieved
+func (s *weatherService) lookupAlerts(ctx context.Context, coords domain.Coordinates) []domain.Alert {
+	report, err := s.GetAlerts(ctx, coords)
+
+	if err != nil {
+		var e *domain.WeatherError
 
-	// Cache the result
-	if err := s.setToCache(ctx, cacheKey, weather); err != nil {
-		s.logger.Warn("failed to cache weather data", zap.Error(err))
-		// Don't fail the request if caching fails
+		// Alerts are simply absent where no provider publishes them
+		if errors.As(err, &e) && e.Code == "LOCATION_NOT_SUPPORTED" {
+			return nil
+		}
+
+		s.logger.Warn("weather returned without alerts", zap.Error(err))
+		return nil
 	}
 
-	s.logger.Info("weather retrieved successfully",
-		zap.Float64("latitude", coords.Latitude),
-		zap.Float64("longitude", coords.Longitude),
-		zap.String("category", string(category)),
-	)
+	return report.Alerts
+}
 
-	// Log to database if available
-	if s.db != nil {
-		s.logWeatherRequest(ctx, coords, weather, time.Since(startTime), cacheHit)
+// finishHourly trims an hourly forecast to the requested window and categorizes it.
+//
+// Parameters:
+//   - forecast: Full hourly forecast, as cached
+//   - hours: Maximum number of periods to keep (0 or less keeps all)
+//   - profile: Categorization profile to apply
+//
+// Returns:
+//   - *domain.HourlyForecast: The trimmed, categorized forecast
+func (s *weatherService) finishHourly(forecast *domain.HourlyForecast, hours int, profile domain.CategoryProfile) *domain.HourlyForecast {
+	forecast = limitHours(forecast, hours)
+	s.categorizePeriods(profile, forecast.Periods)
+	forecast.Profile = profile.Name
+
+	return forecast
+}
+
+// limitHours caps the number of hourly periods in a forecast.
+//
+// Parameters:
+//   - forecast: Hourly forecast to trim
+//   - hours: Maximum number of periods to keep (0 or less keeps all)
+//
+// Returns:
+//   - *domain.HourlyForecast: The same forecast with at most 'hours' periods
+func limitHours(forecast *domain.HourlyForecast, hours int) *domain.HourlyForecast {
+	if hours > 0 && len(forecast.Periods) > hours {
+		forecast.Periods = forecast.Periods[:hours]
 	}
 
-	return weather, nil
+	return forecast
+}
+
+// buildPeriods converts raw provider periods into domain periods.
+// Categories are assigned per request by categorizePeriods.
+//
+// Parameters:
+//   - periods: Raw forecast periods from the weather client
+//
+// Returns:
+//   - []domain.ForecastPeriod: Periods with temperatures populated
+func (s *weatherService) buildPeriods(periods []ports.PeriodData) []domain.ForecastPeriod {
+	result := make([]domain.ForecastPeriod, 0, len(periods))
+
+	for _, p := range periods {
+		temperature := domain.Temperature{
+			Value: p.Temperature,
+			Unit:  p.Unit,
+		}
+
+		result = append(result, domain.ForecastPeriod{
+			Name:                     p.Name,
+			StartTime:                p.StartTime,
+			EndTime:                  p.EndTime,
+			IsDaytime:                p.IsDaytime,
+			Temperature:              temperature,
+			ShortForecast:            p.ShortForecast,
+			DetailedForecast:         p.DetailedForecast,
+			Wind:                     p.Wind,
+			PrecipitationProbability: p.PrecipitationProbability,
+			RelativeHumidity:         p.RelativeHumidity,
+			Dewpoint:                 p.Dewpoint,
+			Icon:                     p.Icon,
+		})
+	}
+
+	return result
 }
 
 // logWeatherRequest logs weather request details to the database.
@@ -170,6 +988,23 @@ func (s *weatherService) logWeatherRequest(ctx context.Context, coords domain.Co
 		Category:        string(weather.Category),
 		ResponseTimeMs:  int(responseTime.Milliseconds()),
 		CacheHit:        cacheHit,
+
+		PrecipitationProbability: weather.PrecipitationProbability,
+		RelativeHumidity:         weather.RelativeHumidity,
+		Icon:                     weather.Icon,
+		DetailedForecast:         weather.DetailedForecast,
+	}
+
+	if w := weather.Wind; w != nil {
+		req.WindSpeedMin = &w.MinSpeed.Value
+		req.WindSpeedMax = &w.MaxSpeed.Value
+		req.WindSpeedUnit = string(w.MaxSpeed.Unit)
+		req.WindDirection = w.Direction
+	}
+
+	if d := weather.Dewpoint; d != nil {
+		req.Dewpoint = &d.Value
+		req.DewpointUnit = string(d.Unit)
 	}
 
 	if err := s.db.LogWeatherRequest(ctx, req); err != nil {
@@ -178,91 +1013,296 @@ func (s *weatherService) logWeatherRequest(ctx context.Context, coords domain.Co
 	}
 }
 
-// generateCacheKey creates a unique cache key for the given coordinates.
+// generateCacheKey creates a unique cache key for the given coordinates. Keys start
+// with the kind, so that every entry of one kind can be deleted with a pattern
+// such as "weather:*".
 //
 // Parameters:
+//   - kind: Type of data being cached (weather, forecast, etc.)
 //   - coords: Geographic coordinates to generate key for
 //
 // Returns:
-//   - string: MD5 hash of rounded coordinates for use as a cache key
-func (s *weatherService) generateCacheKey(coords domain.Coordinates) string {
+//   - string: Kind followed by an MD5 hash of kind and rounded coordinates
+func (s *weatherService) generateCacheKey(kind string, coords domain.Coordinates) string {
 	// Round coordinates to reduce cache misses for nearby locations
 	lat := fmt.Sprintf("%.2f", coords.Latitude)
 	lon := fmt.Sprintf("%.2f", coords.Longitude)
-	data := fmt.Sprintf("weather:%s:%s", lat, lon)
+	data := fmt.Sprintf("%s:%s:%s", kind, lat, lon)
 
-	return fmt.Sprintf("%x", md5.Sum([]byte(data)))
+	return fmt.Sprintf("%s:%x", kind, md5.Sum([]byte(data)))
 }
 
-// getFromCache attempts to retrieve weather data from the cache.
+// forecastKey is where forecast data for a location is cached. Data is keyed by the
+// native location of the provider expected to serve it, such as the NWS grid cell, so
+// every point in a cell shares one entry. Data another provider served instead, after
+// a failover, is keyed by coordinates so it never passes for the expected provider's.
+type forecastKey struct {
+	// native is the key derived from the provider's native location
+	native string
+
+	// provider is the provider the native key belongs to; empty if native is the coordinate key
+	provider string
+
+	// coordinates is the key for data served by any other provider
+	coordinates string
+}
+
+// keyFor returns the key to cache data served by a provider under.
+//
+// Parameters:
+//   - provider: Name of the provider that served the data
+//
+// Returns:
+//   - string: The native key if the provider owns it, otherwise the coordinate key
+func (k forecastKey) keyFor(provider string) string {
+	if provider == k.provider {
+		return k.native
+	}
+
+	return k.coordinates
+}
+
+// forecastCacheKey generates the cache keys for forecast data; coordinates are used
+// throughout when the location cannot be resolved.
 //
 // Parameters:
 //   - ctx: Context for cancellation
-//   - key: Cache key to look up
+//   - kind: Type of forecast being cached (weather, forecast or hourly)
+//   - coords: Geographic coordinates of the request
 //
 // Returns:
-//   - *domain.Weather: Cached weather data if found and valid
-//   - error: Cache miss error or JSON unmarshal error
-func (s *weatherService) getFromCache(ctx context.Context, key string) (*domain.Weather, error) {
-	data, err := s.cache.Get(ctx, key)
+//   - forecastKey: Native key (kind followed by an MD5 hash of kind and location key)
+//     and coordinate key
+func (s *weatherService) forecastCacheKey(ctx context.Context, kind string, coords domain.Coordinates) forecastKey {
+	key := forecastKey{coordinates: s.generateCacheKey(kind, coords)}
+	locationKey, err := s.client.LocationKey(ctx, coords)
 
 	if err != nil {
-		return nil, err
+		s.logger.Debug("failed to resolve location key, keying cache by coordinates",
+			zap.Float64("latitude", coords.Latitude),
+			zap.Float64("longitude", coords.Longitude),
+			zap.Error(err),
+		)
+
+		key.native = key.coordinates
+
+		return key
 	}
 
-	var weather domain.Weather
+	// Location keys are prefixed with the provider name, as in "nws:OKX/33,35"
+	key.provider, _, _ = strings.Cut(locationKey, ":")
+	key.native = fmt.Sprintf("%s:%x", kind, md5.Sum([]byte(kind+":"+locationKey)))
 
-	if err := json.Unmarshal(data, &weather); err != nil {
-		return nil, err
+	return key
+}
+
+// getForecastFromCache looks up forecast data under its native key and then under its
+// coordinate key, where data served by a fallback provider is kept.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - key: Cache keys of the forecast
+//   - policy: Cache policy for the kind of forecast
+//   - dest: Pointer to the value the cached data is decoded into
+//   - fetch: Retrieves the value from the weather client when a refresh is due
+//
+// Returns:
+//   - bool: Whether the data is stale
+//   - error: Cache miss, expired entry or decoding error
+func (s *weatherService) getForecastFromCache(ctx context.Context, key forecastKey, policy cachePolicy, dest interface{}, fetch fetchFunc) (bool, error) {
+	stale, err := s.getFromCache(ctx, key.native, policy, dest, fetch)
+
+	if err == nil || key.coordinates == key.native {
+		return stale, err
 	}
 
-	return &weather, nil
+	return s.getFromCache(ctx, key.coordinates, policy, dest, fetch)
+}
+
+// routeByProvider wraps a forecast fetch so that its result is cached under the key
+// of the provider that served it rather than always under the native key.
+//
+// Parameters:
+//   - key: Cache keys of the forecast
+//   - fetch: Retrieves a *domain.Weather, *domain.Forecast or *domain.HourlyForecast
+//
+// Returns:
+//   - fetchFunc: Fetch whose value carries the key to cache it under
+func routeByProvider(key forecastKey, fetch fetchFunc) fetchFunc {
+	return func(ctx context.Context) (interface{}, ports.Freshness, error) {
+		value, freshness, err := fetch(ctx)
+
+		if err != nil {
+			return value, freshness, err
+		}
+
+		var provider string
+
+		switch v := value.(type) {
+		case *domain.Weather:
+			provider = v.Provider
+		case *domain.Forecast:
+			provider = v.Provider
+		case *domain.HourlyForecast:
+			provider = v.Provider
+		}
+
+		return routedValue{key: key.keyFor(provider), value: value}, freshness, nil
+	}
 }
 
-// setToCache stores weather data in the cache with the configured TTL.
+// getFromCache attempts to retrieve cached data and decode it into dest. Data past
+// its soft expiry but before its hard expiry is still returned, flagged as stale,
+// and refreshed in the background with fetch.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - key: Cache key to look up
+//   - policy: Cache policy for the key
+//   - dest: Pointer to the value the cached data is decoded into
+//   - fetch: Retrieves the value from the weather client when a refresh is due
+//
+// Returns:
+//   - bool: Whether the data is stale
+//   - error: Cache miss, expired entry or decoding error
+func (s *weatherService) getFromCache(ctx context.Context, key string, policy cachePolicy, dest interface{}, fetch fetchFunc) (bool, error) {
+	entry, ok := s.loadEntry(ctx, key)
+
+	if !ok {
+		return false, errCacheMiss
+	}
+
+	now := time.Now()
+
+	if now.After(entry.HardExpiry) {
+		return false, errEntryExpired
+	}
+
+	if err := entry.decode(dest); err != nil {
+		return false, err
+	}
+
+	if !now.After(entry.SoftExpiry) {
+		return false, nil
+	}
+
+	s.revalidate(ctx, key, policy, fetch)
+
+	return true, nil
+}
+
+// setToCache stores a cache entry, kept for the policy's TTL plus its staleness window.
 //
 // Parameters:
 //   - ctx: Context for cancellation
 //   - key: Cache key to store data under
-//   - weather: Weather data to cache
+//   - entry: Entry holding the encoded data
+//   - policy: Cache policy for the key
 //
 // Returns:
-//   - error: JSON marshal error or cache storage error (non-fatal)
-func (s *weatherService) setToCache(ctx context.Context, key string, weather *domain.Weather) error {
-	data, err := json.Marshal(weather)
+//   - error: Compression error or cache storage error (non-fatal)
+func (s *weatherService) setToCache(ctx context.Context, key string, entry cacheEntry, policy cachePolicy) error {
+	data, err := entry.marshal(s.compression, s.compressAbove)
 
 	if err != nil {
 		return err
 	}
 
-	return s.cache.Set(ctx, key, data, s.cacheTTL)
+	return s.cache.Set(ctx, key, data, policy.expiry())
 }
 
-// categorizeTemperature classifies a temperature reading into hot, cold, or moderate categories.
+// resolveProfile looks up a categorization profile by name.
 //
 // Parameters:
-//   - temp: Temperature value with unit (Celsius or Fahrenheit)
+//   - name: Profile name; empty selects the configured default
 //
 // Returns:
-//   - domain.TemperatureCategory: Cold (<50°F), Hot (>85°F), or Moderate (50-85°F)
-func (s *weatherService) categorizeTemperature(temp domain.Temperature) domain.TemperatureCategory {
-	fahrenheit := temp.Value
+//   - domain.CategoryProfile: The matching profile
+//   - error: WeatherError with code INVALID_PROFILE if no profile has that name
+func (s *weatherService) resolveProfile(name string) (domain.CategoryProfile, error) {
+	if name == "" {
+		name = s.defaultProfile
+	}
 
-	if temp.Unit == domain.Celsius {
-		fahrenheit = (temp.Value * 9 / 5) + 32
+	profile, ok := s.profiles[name]
+
+	if !ok {
+		return domain.CategoryProfile{}, &domain.WeatherError{
+			Code:    "INVALID_PROFILE",
+			Message: fmt.Sprintf("Unknown categorization profile '%s'", name),
+		}
 	}
 
-	const (
-		coldThreshold = 50.0
-		hotThreshold  = 85.0
-	)
+	return profile, nil
+}
+
+// categorizePeriods assigns a category to each forecast period in place.
+//
+// Parameters:
+//   - profile: Categorization profile to apply
+//   - periods: Forecast periods to categorize
+func (s *weatherService) categorizePeriods(profile domain.CategoryProfile, periods []domain.ForecastPeriod) {
+	for i := range periods {
+		p := &periods[i]
+		p.Category = s.categorizeTemperature(profile, p.Temperature, p.RelativeHumidity, p.Wind)
+	}
+}
+
+// categorizeObservation assigns a category to an observation, taking the
+// measured humidity and wind into account for profiles with adjustments.
+//
+// Parameters:
+//   - profile: Categorization profile to apply
+//   - observation: Observation to categorize in place
+func (s *weatherService) categorizeObservation(profile domain.CategoryProfile, observation *domain.Observation) {
+	observation.Category = profile.Categorize(domain.CategoryConditions{
+		Temperature:      observation.Temperature,
+		RelativeHumidity: observation.RelativeHumidity,
+		WindSpeed:        observation.WindSpeed,
+	})
+	observation.Profile = profile.Name
+}
+
+// categorizeTemperature classifies a forecast temperature using a categorization profile.
+//
+// Parameters:
+//   - profile: Categorization profile supplying the temperature bands
+//   - temp: Temperature value with unit (Celsius, Fahrenheit or Kelvin)
+//   - humidity: Forecast relative humidity for profile adjustments (may be nil)
+//   - wind: Forecast wind; the top of the speed range is used for adjustments (may be nil)
+//
+// Returns:
+//   - domain.TemperatureCategory: Category of the band containing the adjusted temperature
+func (s *weatherService) categorizeTemperature(profile domain.CategoryProfile, temp domain.Temperature, humidity *float64, wind *domain.Wind) domain.TemperatureCategory {
+	conditions := domain.CategoryConditions{
+		Temperature:      temp,
+		RelativeHumidity: humidity,
+	}
 
-	switch {
-	case fahrenheit < coldThreshold:
-		return domain.Cold
-	case fahrenheit > hotThreshold:
-		return domain.Hot
-	default:
-		return domain.Moderate
+	if wind != nil {
+		conditions.WindSpeed = &wind.MaxSpeed
 	}
+
+	return profile.Categorize(conditions)
+}
+
+// feelsLike computes the apparent temperature for forecast conditions.
+//
+// Parameters:
+//   - temp: Forecast air temperature
+//   - humidity: Forecast relative humidity (may be nil)
+//   - wind: Forecast wind; the top of the speed range is used (may be nil)
+//
+// Returns:
+//   - *domain.Temperature: Heat index, wind chill or the air temperature, in the unit of temp
+func (s *weatherService) feelsLike(temp domain.Temperature, humidity *float64, wind *domain.Wind) *domain.Temperature {
+	var speed *domain.Speed
+
+	if wind != nil {
+		speed = &wind.MaxSpeed
+	}
+
+	apparent := domain.ApparentTemperature(temp, humidity, speed)
+
+	return &apparent
 }
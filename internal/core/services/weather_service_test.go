@@ -4,6 +4,8 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -39,6 +41,96 @@ func (m *MockWeatherClient) GetForecast(ctx context.Context, coords domain.Coord
 	return args.Get(0).(*ports.WeatherData), args.Error(1)
 }
 
+// GetForecastPeriods mocks the weather client GetForecastPeriods method.
+//
+// Parameters:
+//   - ctx: Context for the request
+//   - coords: Geographic coordinates
+//
+// Returns:
+//   - *ports.ForecastData: Mocked forecast periods
+//   - error: Mocked error if configured
+func (m *MockWeatherClient) GetForecastPeriods(ctx context.Context, coords domain.Coordinates) (*ports.ForecastData, error) {
+	args := m.Called(ctx, coords)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ports.ForecastData), args.Error(1)
+}
+
+// GetHourlyForecast mocks the weather client GetHourlyForecast method.
+//
+// Parameters:
+//   - ctx: Context for the request
+//   - coords: Geographic coordinates
+//
+// Returns:
+//   - *ports.ForecastData: Mocked hourly periods
+//   - error: Mocked error if configured
+func (m *MockWeatherClient) GetHourlyForecast(ctx context.Context, coords domain.Coordinates) (*ports.ForecastData, error) {
+	args := m.Called(ctx, coords)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ports.ForecastData), args.Error(1)
+}
+
+// GetObservation mocks the weather client GetObservation method.
+//
+// Parameters:
+//   - ctx: Context for the request
+//   - coords: Geographic coordinates
+//
+// Returns:
+//   - *ports.ObservationData: Mocked station observation
+//   - error: Mocked error if configured
+func (m *MockWeatherClient) GetObservation(ctx context.Context, coords domain.Coordinates) (*ports.ObservationData, error) {
+	args := m.Called(ctx, coords)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ports.ObservationData), args.Error(1)
+}
+
+// GetAlerts mocks the weather client GetAlerts method.
+//
+// Parameters:
+//   - ctx: Context for the request
+//   - coords: Geographic coordinates
+//
+// Returns:
+//   - []ports.AlertData: Mocked active alerts
+//   - error: Mocked error if configured
+func (m *MockWeatherClient) GetAlerts(ctx context.Context, coords domain.Coordinates) ([]ports.AlertData, error) {
+	args := m.Called(ctx, coords)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]ports.AlertData), args.Error(1)
+}
+
+// LocationKey mocks the weather client LocationKey method.
+//
+// Parameters:
+//   - ctx: Context for the request
+//   - coords: Geographic coordinates
+//
+// Returns:
+//   - string: Mocked location key
+//   - error: Mocked error if configured
+func (m *MockWeatherClient) LocationKey(ctx context.Context, coords domain.Coordinates) (string, error) {
+	args := m.Called(ctx, coords)
+	return args.String(0), args.Error(1)
+}
+
 // MockCacheService is a mock implementation of the CacheService interface.
 type MockCacheService struct {
 	mock.Mock
@@ -103,6 +195,20 @@ func (m *MockCacheService) Clear(ctx context.Context) error {
 	return args.Error(0)
 }
 
+// DeletePattern mocks the cache DeletePattern method.
+//
+// Parameters:
+//   - ctx: Context for the request
+//   - pattern: Glob pattern of the keys to delete
+//
+// Returns:
+//   - int64: Mocked number of deleted keys
+//   - error: Mocked error if configured
+func (m *MockCacheService) DeletePattern(ctx context.Context, pattern string) (int64, error) {
+	args := m.Called(ctx, pattern)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 // TestWeatherService_GetWeather tests the GetWeather method with various scenarios.
 func TestWeatherService_GetWeather(t *testing.T) {
 	logger := zap.NewNop()
@@ -177,10 +283,11 @@ func TestWeatherService_GetWeather(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockClient := new(MockWeatherClient)
 			mockCache := new(MockCacheService)
-			service := NewWeatherService(mockClient, mockCache, nil, logger)
+			service := NewWeatherService(mockClient, mockCache, nil, Config{}, logger)
 
 			// Mock cache miss to force API call
 			mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+			mockClient.On("LocationKey", mock.Anything, mock.Anything).Return("nws:OKX/33,35", nil).Maybe()
 			mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 			if tt.mockData != nil || tt.mockError != nil {
@@ -188,7 +295,9 @@ func TestWeatherService_GetWeather(t *testing.T) {
 					Return(tt.mockData, tt.mockError)
 			}
 
-			weather, err := service.GetWeather(context.Background(), tt.coords)
+			mockClient.On("GetAlerts", mock.Anything, tt.coords).Return([]ports.AlertData{}, nil).Maybe()
+
+			weather, err := service.GetWeather(context.Background(), tt.coords, "")
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -205,6 +314,1232 @@ func TestWeatherService_GetWeather(t *testing.T) {
 	}
 }
 
+// TestWeatherService_GetForecast tests the GetForecast method with various scenarios.
+func TestWeatherService_GetForecast(t *testing.T) {
+	logger := zap.NewNop()
+	start := time.Date(2024, 7, 1, 6, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		coords             domain.Coordinates
+		mockData           *ports.ForecastData
+		mockError          error
+		expectedError      bool
+		expectedCategories []domain.TemperatureCategory
+	}{
+		{
+			name:   "all periods categorized",
+			coords: domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060},
+			mockData: &ports.ForecastData{
+				Periods: []ports.PeriodData{
+					{Name: "Today", StartTime: start, EndTime: start.Add(12 * time.Hour), IsDaytime: true, Temperature: 92, Unit: domain.Fahrenheit, ShortForecast: "Sunny"},
+					{Name: "Tonight", StartTime: start.Add(12 * time.Hour), EndTime: start.Add(24 * time.Hour), Temperature: 70, Unit: domain.Fahrenheit, ShortForecast: "Clear"},
+					{Name: "Tuesday", StartTime: start.Add(24 * time.Hour), EndTime: start.Add(36 * time.Hour), IsDaytime: true, Temperature: 5, Unit: domain.Celsius, ShortForecast: "Snow"},
+				},
+			},
+			expectedCategories: []domain.TemperatureCategory{domain.Hot, domain.Moderate, domain.Cold},
+		},
+		{
+			name:          "invalid coordinates",
+			coords:        domain.Coordinates{Latitude: 91, Longitude: 0},
+			expectedError: true,
+		},
+		{
+			name:          "client error",
+			coords:        domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060},
+			mockError:     errors.New("API error"),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockWeatherClient)
+			mockCache := new(MockCacheService)
+			service := NewWeatherService(mockClient, mockCache, nil, Config{}, logger)
+
+			mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+			mockClient.On("LocationKey", mock.Anything, mock.Anything).Return("nws:OKX/33,35", nil).Maybe()
+			mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+			if tt.mockData != nil || tt.mockError != nil {
+				mockClient.On("GetForecastPeriods", mock.Anything, tt.coords).
+					Return(tt.mockData, tt.mockError)
+			}
+
+			forecast, err := service.GetForecast(context.Background(), tt.coords, "")
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, forecast)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, forecast.Periods, len(tt.mockData.Periods))
+
+				for i, period := range forecast.Periods {
+					assert.Equal(t, tt.mockData.Periods[i].Name, period.Name)
+					assert.Equal(t, tt.mockData.Periods[i].StartTime, period.StartTime)
+					assert.Equal(t, tt.expectedCategories[i], period.Category)
+				}
+			}
+
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+// TestWeatherService_GetHourlyForecast tests the hours window and hourly cache TTL.
+func TestWeatherService_GetHourlyForecast(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	start := time.Date(2024, 7, 1, 6, 0, 0, 0, time.UTC)
+
+	periods := make([]ports.PeriodData, 0, 48)
+
+	for i := 0; i < 48; i++ {
+		periods = append(periods, ports.PeriodData{
+			StartTime:   start.Add(time.Duration(i) * time.Hour),
+			EndTime:     start.Add(time.Duration(i+1) * time.Hour),
+			Temperature: float64(60 + i),
+			Unit:        domain.Fahrenheit,
+		})
+	}
+
+	tests := []struct {
+		name          string
+		hours         int
+		expectedCount int
+	}{
+		{name: "all hours", hours: 0, expectedCount: 48},
+		{name: "capped window", hours: 12, expectedCount: 12},
+		{name: "window larger than data", hours: 100, expectedCount: 48},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockWeatherClient)
+			mockCache := new(MockCacheService)
+			service := NewWeatherService(mockClient, mockCache, nil, Config{HourlyCacheTTL: 20 * time.Minute}, logger)
+
+			mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+			mockClient.On("LocationKey", mock.Anything, mock.Anything).Return("nws:OKX/33,35", nil)
+			mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, 20*time.Minute).Return(nil)
+			mockClient.On("GetHourlyForecast", mock.Anything, coords).
+				Return(&ports.ForecastData{Periods: periods}, nil)
+
+			forecast, err := service.GetHourlyForecast(context.Background(), coords, tt.hours, "")
+
+			assert.NoError(t, err)
+			assert.Len(t, forecast.Periods, tt.expectedCount)
+			assert.Equal(t, start, forecast.Periods[0].StartTime)
+			mockClient.AssertExpectations(t)
+			mockCache.AssertExpectations(t)
+		})
+	}
+
+	t.Run("client error", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, Config{}, logger)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockClient.On("LocationKey", mock.Anything, mock.Anything).Return("nws:OKX/33,35", nil)
+		mockClient.On("GetHourlyForecast", mock.Anything, coords).Return(nil, errors.New("API error"))
+
+		forecast, err := service.GetHourlyForecast(context.Background(), coords, 0, "")
+
+		assert.Error(t, err)
+		assert.Nil(t, forecast)
+	})
+}
+
+// TestWeatherService_GetObservation tests the GetObservation method with various scenarios.
+func TestWeatherService_GetObservation(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	humidity := 65.0
+	observedAt := time.Now().Add(-20 * time.Minute).UTC()
+
+	t.Run("successful observation", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, Config{ObservationCacheTTL: 2 * time.Minute}, logger)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, 2*time.Minute).Return(nil)
+		mockClient.On("GetObservation", mock.Anything, coords).Return(&ports.ObservationData{
+			StationID:        "KNYC",
+			StationName:      "New York City, Central Park",
+			StationDistance:  domain.Distance{Value: 8.2, Unit: domain.Kilometers},
+			ObservedAt:       observedAt,
+			Temperature:      domain.Temperature{Value: 22.8, Unit: domain.Celsius},
+			RelativeHumidity: &humidity,
+			WindSpeed:        &domain.Speed{Value: 14.8, Unit: domain.KilometersPerHour},
+		}, nil)
+
+		observation, err := service.GetObservation(context.Background(), coords, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "KNYC", observation.StationID)
+		assert.Equal(t, observedAt, observation.ObservedAt)
+		assert.Equal(t, 22.8, observation.Temperature.Value)
+		assert.Equal(t, &humidity, observation.RelativeHumidity)
+		assert.Nil(t, observation.Pressure)
+		mockClient.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("client error", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, Config{}, logger)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockClient.On("GetObservation", mock.Anything, coords).Return(nil, errors.New("no recent observations"))
+
+		observation, err := service.GetObservation(context.Background(), coords, "")
+
+		var weatherErr *domain.WeatherError
+
+		assert.Nil(t, observation)
+		assert.ErrorAs(t, err, &weatherErr)
+		assert.Equal(t, "OBSERVATION_RETRIEVAL_ERROR", weatherErr.Code)
+	})
+
+	t.Run("location not supported", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, Config{}, logger)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockClient.On("GetObservation", mock.Anything, coords).
+			Return(nil, fmt.Errorf("%w: no weather provider serves get-observation", domain.ErrLocationNotSupported))
+
+		observation, err := service.GetObservation(context.Background(), coords, "")
+
+		var weatherErr *domain.WeatherError
+
+		assert.Nil(t, observation)
+		assert.ErrorAs(t, err, &weatherErr)
+		assert.Equal(t, "LOCATION_NOT_SUPPORTED", weatherErr.Code)
+	})
+}
+
+// TestWeatherService_GetAlerts tests the GetAlerts method and alert attachment to GetWeather.
+func TestWeatherService_GetAlerts(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	expires := time.Now().Add(6 * time.Hour).UTC()
+
+	heatAdvisory := ports.AlertData{
+		ID:            "urn:oid:2.49.0.1.840.0.1",
+		Event:         "Heat Advisory",
+		Severity:      domain.SeverityModerate,
+		Urgency:       domain.UrgencyExpected,
+		Certainty:     domain.CertaintyLikely,
+		Headline:      "Heat Advisory issued for New York County",
+		AffectedZones: []string{"https://api.weather.gov/zones/forecast/NYZ072"},
+		Expires:       expires,
+	}
+
+	t.Run("successful alerts", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, Config{AlertsCacheTTL: 30 * time.Second}, logger)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, 30*time.Second).Return(nil)
+		mockClient.On("GetAlerts", mock.Anything, coords).Return([]ports.AlertData{heatAdvisory}, nil)
+
+		report, err := service.GetAlerts(context.Background(), coords)
+
+		assert.NoError(t, err)
+		assert.Len(t, report.Alerts, 1)
+		assert.Equal(t, "Heat Advisory", report.Alerts[0].Event)
+		assert.Equal(t, domain.SeverityModerate, report.Alerts[0].Severity)
+		assert.Equal(t, expires, report.Alerts[0].Expires)
+		mockClient.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("client error", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, Config{}, logger)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockClient.On("GetAlerts", mock.Anything, coords).Return(nil, errors.New("API error"))
+
+		report, err := service.GetAlerts(context.Background(), coords)
+
+		var weatherErr *domain.WeatherError
+
+		assert.Nil(t, report)
+		assert.ErrorAs(t, err, &weatherErr)
+		assert.Equal(t, "ALERTS_RETRIEVAL_ERROR", weatherErr.Code)
+	})
+
+	t.Run("weather includes active alerts", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, Config{}, logger)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockClient.On("LocationKey", mock.Anything, mock.Anything).Return("nws:OKX/33,35", nil)
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockClient.On("GetForecast", mock.Anything, coords).
+			Return(&ports.WeatherData{Temperature: 95, Unit: domain.Fahrenheit, Forecast: "Sunny"}, nil)
+		mockClient.On("GetAlerts", mock.Anything, coords).Return([]ports.AlertData{heatAdvisory}, nil)
+
+		weather, err := service.GetWeather(context.Background(), coords, "")
+
+		assert.NoError(t, err)
+		assert.Len(t, weather.Alerts, 1)
+		assert.Equal(t, "Heat Advisory", weather.Alerts[0].Event)
+	})
+
+	t.Run("weather succeeds when alerts fail", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, Config{}, logger)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockClient.On("LocationKey", mock.Anything, mock.Anything).Return("nws:OKX/33,35", nil)
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockClient.On("GetForecast", mock.Anything, coords).
+			Return(&ports.WeatherData{Temperature: 70, Unit: domain.Fahrenheit, Forecast: "Cloudy"}, nil)
+		mockClient.On("GetAlerts", mock.Anything, coords).Return(nil, errors.New("API error"))
+
+		weather, err := service.GetWeather(context.Background(), coords, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Cloudy", weather.Forecast)
+		assert.Empty(t, weather.Alerts)
+	})
+
+	t.Run("weather succeeds when alerts are not supported", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, Config{}, logger)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockClient.On("LocationKey", mock.Anything, mock.Anything).Return("nws:OKX/33,35", nil)
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockClient.On("GetForecast", mock.Anything, coords).
+			Return(&ports.WeatherData{Temperature: 70, Unit: domain.Fahrenheit, Forecast: "Cloudy"}, nil)
+		mockClient.On("GetAlerts", mock.Anything, coords).
+			Return(nil, fmt.Errorf("%w: open-meteo does not publish weather alerts", domain.ErrLocationNotSupported))
+
+		weather, err := service.GetWeather(context.Background(), coords, "")
+
+		assert.NoError(t, err)
+		assert.Empty(t, weather.Alerts)
+	})
+}
+
+// TestWeatherService_GetWeatherDetails tests that forecast details survive the cache round trip.
+func TestWeatherService_GetWeatherDetails(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	precipitation := 40.0
+	humidity := 68.0
+
+	data := &ports.WeatherData{
+		Temperature:      84,
+		Unit:             domain.Fahrenheit,
+		Forecast:         "Chance Showers And Thunderstorms",
+		DetailedForecast: "A chance of showers and thunderstorms after 2pm.",
+		Wind: &domain.Wind{
+			MinSpeed:  domain.Speed{Value: 10, Unit: domain.MilesPerHour},
+			MaxSpeed:  domain.Speed{Value: 15, Unit: domain.MilesPerHour},
+			Direction: "SW",
+		},
+		PrecipitationProbability: &precipitation,
+		RelativeHumidity:         &humidity,
+		Dewpoint:                 &domain.Temperature{Value: 20.5, Unit: domain.Celsius},
+		Icon:                     "https://api.weather.gov/icons/land/day/tsra,40",
+		Provider:                 "nws",
+	}
+
+	mockClient := new(MockWeatherClient)
+	mockCache := new(MockCacheService)
+	service := NewWeatherService(mockClient, mockCache, nil, Config{}, logger)
+
+	var stored []byte
+
+	mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss")).Times(5)
+	mockClient.On("LocationKey", mock.Anything, mock.Anything).Return("nws:OKX/33,35", nil)
+	mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			if stored == nil {
+				stored = args.Get(2).([]byte)
+			}
+		}).
+		Return(nil)
+	mockClient.On("GetForecast", mock.Anything, coords).Return(data, nil).Once()
+	mockClient.On("GetAlerts", mock.Anything, coords).Return([]ports.AlertData{}, nil)
+
+	fresh, err := service.GetWeather(context.Background(), coords, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, data.Wind, fresh.Wind)
+	assert.Equal(t, &precipitation, fresh.PrecipitationProbability)
+	assert.Equal(t, data.Dewpoint, fresh.Dewpoint)
+	assert.Equal(t, data.Icon, fresh.Icon)
+	assert.Equal(t, "nws", fresh.Provider)
+
+	mockCache.On("Get", mock.Anything, mock.Anything).Return(stored, nil).Once()
+	mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+
+	cached, err := service.GetWeather(context.Background(), coords, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, fresh.Wind, cached.Wind)
+	assert.Equal(t, fresh.PrecipitationProbability, cached.PrecipitationProbability)
+	assert.Equal(t, fresh.RelativeHumidity, cached.RelativeHumidity)
+	assert.Equal(t, fresh.Dewpoint, cached.Dewpoint)
+	assert.Equal(t, fresh.DetailedForecast, cached.DetailedForecast)
+	assert.Equal(t, fresh.Provider, cached.Provider, "the serving provider is cached with the data")
+	mockClient.AssertExpectations(t)
+}
+
+// TestWeatherService_ForecastCacheKey tests that forecasts are cached per provider location.
+func TestWeatherService_ForecastCacheKey(t *testing.T) {
+	logger := zap.NewNop()
+	first := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	sameCell := domain.Coordinates{Latitude: 40.7180, Longitude: -74.0020}
+	otherCell := domain.Coordinates{Latitude: 40.7110, Longitude: -74.0101}
+
+	mockClient := new(MockWeatherClient)
+	service := &weatherService{client: mockClient, logger: logger}
+
+	mockClient.On("LocationKey", mock.Anything, first).Return("nws:OKX/33,35", nil)
+	mockClient.On("LocationKey", mock.Anything, sameCell).Return("nws:OKX/33,35", nil)
+	mockClient.On("LocationKey", mock.Anything, otherCell).Return("nws:OKX/32,35", nil)
+
+	ctx := context.Background()
+
+	assert.Equal(t, service.forecastCacheKey(ctx, "weather", first).native, service.forecastCacheKey(ctx, "weather", sameCell).native,
+		"points in one grid cell share a key even when their rounded coordinates differ")
+	assert.NotEqual(t, service.forecastCacheKey(ctx, "weather", first).native, service.forecastCacheKey(ctx, "weather", otherCell).native,
+		"points in different grid cells do not share a key even when their rounded coordinates match")
+	assert.NotEqual(t, service.forecastCacheKey(ctx, "weather", first).native, service.forecastCacheKey(ctx, "hourly", first).native)
+
+	t.Run("falls back to coordinates when the location cannot be resolved", func(t *testing.T) {
+		failing := new(MockWeatherClient)
+		failing.On("LocationKey", mock.Anything, first).Return("", errors.New("NWS API returned status 503"))
+		service := &weatherService{client: failing, logger: logger}
+
+		assert.Equal(t, service.generateCacheKey("weather", first), service.forecastCacheKey(ctx, "weather", first).native)
+	})
+
+	t.Run("caches data from a fallback provider under the coordinate key", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, Config{CacheTTL: 5 * time.Minute}, logger).(*weatherService)
+		// NWS resolves the grid but its forecast fails, so the failover client serves Open-Meteo data
+		mockClient.On("LocationKey", mock.Anything, first).Return("nws:OKX/33,35", nil)
+		mockClient.On("GetForecastPeriods", mock.Anything, first).Return(&ports.ForecastData{Provider: "openmeteo"}, nil).Once()
+
+		key := service.forecastCacheKey(ctx, "forecast", first)
+
+		var stored []byte
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss")).Times(3)
+		mockCache.On("Set", mock.Anything, key.coordinates, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { stored = args.Get(2).([]byte) }).
+			Return(nil).Once()
+
+		forecast, err := service.GetForecast(ctx, first, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "openmeteo", forecast.Provider)
+		mockCache.AssertNotCalled(t, "Set", mock.Anything, key.native, mock.Anything, mock.Anything)
+
+		// The grid key stays empty, and the fallback data is found under the coordinates
+		mockCache.On("Get", mock.Anything, key.native).Return(nil, errors.New("cache miss")).Once()
+		mockCache.On("Get", mock.Anything, key.coordinates).Return(stored, nil).Once()
+
+		cached, err := service.GetForecast(ctx, first, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "openmeteo", cached.Provider)
+		mockClient.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("caches data from the keyed provider under the grid key", func(t *testing.T) {
+		key := service.forecastCacheKey(ctx, "forecast", first)
+
+		assert.Equal(t, key.native, key.keyFor("nws"))
+		assert.Equal(t, key.coordinates, key.keyFor("openmeteo"))
+	})
+}
+
+// stubLocker is a LockService whose lock is held by another instance for a number of tries.
+type stubLocker struct {
+	// heldFor is how many TryLock calls find the lock held; negative holds it forever
+	heldFor int
+
+	// tries counts TryLock calls
+	tries int
+
+	// unlocked records whether the lock was released with its token
+	unlocked bool
+}
+
+// TryLock reports the lock as held elsewhere until heldFor tries have been made.
+func (l *stubLocker) TryLock(context.Context, string, time.Duration) (string, bool, error) {
+	l.tries++
+
+	if l.heldFor < 0 || l.tries <= l.heldFor {
+		return "", false, nil
+	}
+
+	return "token", true, nil
+}
+
+// Unlock records the release.
+func (l *stubLocker) Unlock(_ context.Context, _ string, token string) error {
+	l.unlocked = token == "token"
+
+	return nil
+}
+
+// TestWeatherService_Coalescing tests that concurrent cache misses share one upstream fetch.
+func TestWeatherService_Coalescing(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	data := &ports.ForecastData{
+		Periods:  []ports.PeriodData{{Name: "Today", Temperature: 75, Unit: domain.Fahrenheit}},
+		Provider: "nws",
+	}
+
+	t.Run("concurrent misses make one upstream call", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, Config{}, logger)
+		release := make(chan struct{})
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		mockClient.On("LocationKey", mock.Anything, coords).Return("nws:OKX/33,35", nil)
+		mockClient.On("GetForecastPeriods", mock.Anything, coords).
+			Run(func(mock.Arguments) { <-release }).
+			Return(data, nil).Once()
+
+		const callers = 10
+
+		var wg sync.WaitGroup
+
+		results := make([]*domain.Forecast, callers)
+		errs := make([]error, callers)
+
+		for i := 0; i < callers; i++ {
+			wg.Add(1)
+
+			go func(i int) {
+				defer wg.Done()
+				results[i], errs[i] = service.GetForecast(context.Background(), coords, "")
+			}(i)
+		}
+
+		// Give every caller time to join the fetch before it completes
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		for i := 0; i < callers; i++ {
+			assert.NoError(t, errs[i])
+			assert.Equal(t, 75.0, results[i].Periods[0].Temperature.Value)
+		}
+
+		assert.NotSame(t, results[0], results[1], "each caller gets its own copy")
+		mockClient.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("a cancelled caller stops waiting without failing the others", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, Config{}, logger)
+		release := make(chan struct{})
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockClient.On("LocationKey", mock.Anything, coords).Return("nws:OKX/33,35", nil)
+		mockClient.On("GetForecastPeriods", mock.Anything, coords).
+			Run(func(mock.Arguments) { <-release }).
+			Return(data, nil).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancelled := make(chan error)
+
+		go func() {
+			_, err := service.GetForecast(ctx, coords, "")
+			cancelled <- err
+		}()
+
+		waiting := make(chan error)
+
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			_, err := service.GetForecast(context.Background(), coords, "")
+			waiting <- err
+		}()
+
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+
+		assert.ErrorIs(t, <-cancelled, context.Canceled)
+
+		close(release)
+
+		assert.NoError(t, <-waiting)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("waits for another instance holding the fetch lock", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, Config{Locker: &stubLocker{heldFor: -1}}, logger)
+		// The lock holder's clock runs behind, so its result looks older than this fetch
+		cached := cachedEntry(t, time.Now().Add(-time.Minute), cachePolicy{ttl: 5 * time.Minute}, &domain.Forecast{Periods: []domain.ForecastPeriod{{Name: "Today"}}, Provider: "nws"})
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss")).Times(3)
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(cached, nil)
+		mockClient.On("LocationKey", mock.Anything, coords).Return("nws:OKX/33,35", nil)
+
+		forecast, err := service.GetForecast(context.Background(), coords, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Today", forecast.Periods[0].Name)
+		mockClient.AssertNotCalled(t, "GetForecastPeriods", mock.Anything, mock.Anything)
+	})
+
+	t.Run("fetches once the holder releases the lock without a result", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		locker := &stubLocker{heldFor: 2}
+		service := NewWeatherService(mockClient, mockCache, nil, Config{Locker: locker}, logger)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		mockClient.On("LocationKey", mock.Anything, coords).Return("nws:OKX/33,35", nil)
+		mockClient.On("GetForecastPeriods", mock.Anything, coords).Return(data, nil).Once()
+
+		forecast, err := service.GetForecast(context.Background(), coords, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Today", forecast.Periods[0].Name)
+		assert.Equal(t, 3, locker.tries)
+		assert.True(t, locker.unlocked)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("the lock outlives a fetch", func(t *testing.T) {
+		service := NewWeatherService(new(MockWeatherClient), new(MockCacheService), nil, Config{LockTTL: time.Second}, logger)
+
+		assert.Equal(t, sharedFetchTimeout, service.(*weatherService).lockTTL)
+	})
+}
+
+// cachedEntry encodes a value the way the service stores it in the cache, as if it
+// had been cached at storedAt under policy.
+func cachedEntry(t *testing.T, storedAt time.Time, policy cachePolicy, value interface{}) []byte {
+	t.Helper()
+
+	entry, err := newCacheEntry(value, codecJSON, policy)
+	assert.NoError(t, err)
+
+	entry.CreatedAt = storedAt
+	entry.SoftExpiry = storedAt.Add(policy.ttl)
+	entry.HardExpiry = storedAt.Add(policy.expiry())
+
+	data, err := entry.marshal(compressionNone, 0)
+	assert.NoError(t, err)
+
+	return data
+}
+
+// TestWeatherService_StaleCache tests serving cached data past its TTL.
+func TestWeatherService_StaleCache(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	cfg := Config{CacheTTL: time.Minute, ForecastMaxStale: time.Hour}
+	policy := cachePolicy{ttl: time.Minute, maxStale: time.Hour}
+	old := &domain.Forecast{Periods: []domain.ForecastPeriod{{Name: "Yesterday"}}, Provider: "nws"}
+	data := &ports.ForecastData{
+		Periods:  []ports.PeriodData{{Name: "Today", Temperature: 75, Unit: domain.Fahrenheit}},
+		Provider: "nws",
+	}
+
+	t.Run("fresh data is served without a refresh", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, cfg, logger)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(cachedEntry(t, time.Now().Add(-30*time.Second), policy, old), nil)
+		mockClient.On("LocationKey", mock.Anything, coords).Return("nws:OKX/33,35", nil)
+
+		forecast, err := service.GetForecast(context.Background(), coords, "")
+
+		assert.NoError(t, err)
+		assert.False(t, forecast.Stale)
+		assert.Equal(t, "Yesterday", forecast.Periods[0].Name)
+		mockClient.AssertNotCalled(t, "GetForecastPeriods", mock.Anything, mock.Anything)
+	})
+
+	t.Run("stale data is served and refreshed in the background", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, cfg, logger)
+		refreshed := make(chan struct{})
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(cachedEntry(t, time.Now().Add(-10*time.Minute), policy, old), nil)
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, 61*time.Minute).
+			Run(func(mock.Arguments) { close(refreshed) }).
+			Return(nil).Once()
+		mockClient.On("LocationKey", mock.Anything, coords).Return("nws:OKX/33,35", nil)
+		mockClient.On("GetForecastPeriods", mock.Anything, coords).Return(data, nil).Once()
+
+		forecast, err := service.GetForecast(context.Background(), coords, "")
+
+		assert.NoError(t, err)
+		assert.True(t, forecast.Stale)
+		assert.Equal(t, "Yesterday", forecast.Periods[0].Name)
+
+		select {
+		case <-refreshed:
+		case <-time.After(time.Second):
+			t.Fatal("stale entry was not refreshed")
+		}
+
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("stale data is served while the provider is failing", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, cfg, logger)
+		attempted := make(chan struct{})
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(cachedEntry(t, time.Now().Add(-10*time.Minute), policy, old), nil)
+		mockClient.On("LocationKey", mock.Anything, coords).Return("nws:OKX/33,35", nil)
+		mockClient.On("GetForecastPeriods", mock.Anything, coords).
+			Run(func(mock.Arguments) { close(attempted) }).
+			Return(nil, errors.New("NWS API returned status 503")).Once()
+
+		forecast, err := service.GetForecast(context.Background(), coords, "")
+
+		assert.NoError(t, err)
+		assert.True(t, forecast.Stale)
+		assert.Equal(t, "Yesterday", forecast.Periods[0].Name)
+
+		select {
+		case <-attempted:
+		case <-time.After(time.Second):
+			t.Fatal("stale entry was not refreshed")
+		}
+
+		mockCache.AssertNotCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("data past the staleness window is not served", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, cfg, logger)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(cachedEntry(t, time.Now().Add(-2*time.Hour), policy, old), nil)
+		mockClient.On("LocationKey", mock.Anything, coords).Return("nws:OKX/33,35", nil)
+		mockClient.On("GetForecastPeriods", mock.Anything, coords).
+			Return(nil, errors.New("NWS API returned status 503")).Once()
+
+		forecast, err := service.GetForecast(context.Background(), coords, "")
+
+		assert.Error(t, err)
+		assert.Nil(t, forecast)
+	})
+
+	t.Run("stale serving is disabled by default", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, Config{CacheTTL: time.Minute}, logger)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(cachedEntry(t, time.Now().Add(-10*time.Minute), cachePolicy{ttl: time.Minute}, old), nil)
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, time.Minute).Return(nil).Once()
+		mockClient.On("LocationKey", mock.Anything, coords).Return("nws:OKX/33,35", nil)
+		mockClient.On("GetForecastPeriods", mock.Anything, coords).Return(data, nil).Once()
+
+		forecast, err := service.GetForecast(context.Background(), coords, "")
+
+		assert.NoError(t, err)
+		assert.False(t, forecast.Stale)
+		assert.Equal(t, "Today", forecast.Periods[0].Name)
+		mockCache.AssertExpectations(t)
+	})
+}
+
+// TestWeatherService_UpstreamFreshness tests deriving cache TTLs from the provider's caching headers.
+func TestWeatherService_UpstreamFreshness(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	cfg := Config{CacheTTL: 5 * time.Minute, ForecastMaxStale: time.Hour, MinUpstreamTTL: time.Minute, MaxUpstreamTTL: 30 * time.Minute}
+	generated := time.Date(2024, 7, 1, 5, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		expires time.Duration
+		ttl     time.Duration
+	}{
+		{name: "provider expiry replaces the configured TTL", expires: 10 * time.Minute, ttl: 10 * time.Minute},
+		{name: "short expiry is raised to the minimum", expires: 10 * time.Second, ttl: time.Minute},
+		{name: "long expiry is capped at the maximum", expires: 3 * time.Hour, ttl: 30 * time.Minute},
+		{name: "no expiry keeps the configured TTL", ttl: 5 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockWeatherClient)
+			mockCache := new(MockCacheService)
+			service := NewWeatherService(mockClient, mockCache, nil, cfg, logger)
+
+			freshness := ports.Freshness{GeneratedAt: generated, UpdatedAt: generated.Add(-time.Hour)}
+
+			if tt.expires > 0 {
+				freshness.Expires = time.Now().Add(tt.expires)
+			}
+
+			var cachedTTL time.Duration
+
+			mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+			mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) { cachedTTL = args.Get(3).(time.Duration) }).
+				Return(nil).Once()
+			mockClient.On("LocationKey", mock.Anything, coords).Return("nws:OKX/33,35", nil)
+			mockClient.On("GetForecastPeriods", mock.Anything, coords).Return(&ports.ForecastData{
+				Periods:   []ports.PeriodData{{Name: "Today", Temperature: 75, Unit: domain.Fahrenheit}},
+				Provider:  "nws",
+				Freshness: freshness,
+			}, nil)
+
+			forecast, err := service.GetForecast(context.Background(), coords, "")
+
+			assert.NoError(t, err)
+			assert.Equal(t, generated, forecast.GeneratedAt)
+			assert.Equal(t, generated.Add(-time.Hour), forecast.UpdatedAt)
+			assert.InDelta(t, float64(tt.ttl+time.Hour), float64(cachedTTL), float64(time.Second), "the staleness window is kept")
+		})
+	}
+}
+
+// TestWeatherService_NotModified tests that a provider confirming a cached entry with a
+// 304 renews the entry in place instead of downloading and storing the data again.
+func TestWeatherService_NotModified(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	cfg := Config{CacheTTL: 5 * time.Minute, ForecastMaxStale: time.Hour, MinUpstreamTTL: time.Minute, MaxUpstreamTTL: 30 * time.Minute}
+	policy := cachePolicy{ttl: 5 * time.Minute, maxStale: time.Hour}
+	lastModified := time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC)
+
+	held, err := newCacheEntry(&domain.Forecast{Periods: []domain.ForecastPeriod{{Name: "Today"}}, Provider: "nws"}, codecJSON, policy)
+	assert.NoError(t, err)
+
+	held.CreatedAt = time.Now().Add(-10 * time.Minute)
+	held.SoftExpiry = held.CreatedAt.Add(policy.ttl)
+	held.HardExpiry = held.CreatedAt.Add(policy.expiry())
+	held.ETag, held.LastModified = `"v1"`, lastModified
+
+	cached, err := held.marshal(compressionNone, 0)
+	assert.NoError(t, err)
+
+	t.Run("a 304 renews the cached entry", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, cfg, logger).(*weatherService)
+
+		var validators ports.Freshness
+		var stored []byte
+		var storedTTL time.Duration
+
+		mockCache.On("Get", mock.Anything, "forecast:key").Return(cached, nil)
+		mockCache.On("Set", mock.Anything, "forecast:key", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { stored, storedTTL = args.Get(2).([]byte), args.Get(3).(time.Duration) }).
+			Return(nil).Once()
+		mockClient.On("GetForecastPeriods", mock.Anything, coords).
+			Run(func(args mock.Arguments) { validators, _ = ports.ValidatorsFromContext(args.Get(0).(context.Context)) }).
+			Return(nil, &ports.NotModifiedError{Freshness: ports.Freshness{Expires: time.Now().Add(20 * time.Minute)}})
+
+		var forecast domain.Forecast
+
+		err := service.fetchShared(context.Background(), "forecast:key", policy, &forecast, func(ctx context.Context) (interface{}, ports.Freshness, error) {
+			return service.fetchForecast(ctx, coords)
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Today", forecast.Periods[0].Name)
+		assert.Equal(t, `"v1"`, validators.ETag, "the request carries the entry's validators")
+		assert.True(t, lastModified.Equal(validators.LastModified))
+
+		entry, err := unmarshalCacheEntry(stored)
+
+		assert.NoError(t, err)
+		assert.Equal(t, held.Value, entry.Value, "the cached value is kept")
+		assert.Equal(t, `"v1"`, entry.ETag)
+		assert.True(t, lastModified.Equal(entry.LastModified))
+		assert.WithinDuration(t, time.Now(), entry.CreatedAt, 5*time.Second)
+		assert.WithinDuration(t, time.Now().Add(20*time.Minute), entry.SoftExpiry, 5*time.Second)
+		assert.InDelta(t, float64(20*time.Minute+time.Hour), float64(storedTTL), float64(time.Second))
+	})
+
+	t.Run("validators of a download are cached with it", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, cfg, logger).(*weatherService)
+
+		var revalidated bool
+		var stored []byte
+
+		mockCache.On("Get", mock.Anything, "forecast:key").Return(nil, errors.New("cache miss"))
+		mockCache.On("Set", mock.Anything, "forecast:key", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { stored = args.Get(2).([]byte) }).
+			Return(nil).Once()
+		mockClient.On("GetForecastPeriods", mock.Anything, coords).
+			Run(func(args mock.Arguments) { _, revalidated = ports.ValidatorsFromContext(args.Get(0).(context.Context)) }).
+			Return(&ports.ForecastData{
+				Periods:   []ports.PeriodData{{Name: "Tonight"}},
+				Provider:  "nws",
+				Freshness: ports.Freshness{ETag: `"v2"`, LastModified: lastModified.Add(time.Hour)},
+			}, nil)
+
+		err := service.fetchShared(context.Background(), "forecast:key", policy, nil, func(ctx context.Context) (interface{}, ports.Freshness, error) {
+			return service.fetchForecast(ctx, coords)
+		})
+
+		assert.NoError(t, err)
+		assert.False(t, revalidated, "nothing is cached to revalidate")
+
+		entry, err := unmarshalCacheEntry(stored)
+
+		assert.NoError(t, err)
+		assert.Equal(t, `"v2"`, entry.ETag)
+		assert.True(t, lastModified.Add(time.Hour).Equal(entry.LastModified))
+	})
+}
+
+// TestWeatherService_WarmLocation tests refreshing cache entries before they go stale.
+func TestWeatherService_WarmLocation(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	cfg := Config{CacheTTL: 5 * time.Minute, HourlyCacheTTL: 15 * time.Minute}
+
+	t.Run("refreshes entries that are missing or would go stale within the horizon", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, cfg, logger)
+
+		// weather stays fresh past the horizon, forecast does not, hourly is missing; each
+		// refresh reads the entry again for its validators
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(cachedEntry(t, time.Now().Add(-time.Minute), cachePolicy{ttl: 5 * time.Minute}, &domain.Weather{}), nil).Once()
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(cachedEntry(t, time.Now().Add(-4*time.Minute), cachePolicy{ttl: 5 * time.Minute}, &domain.Forecast{}), nil).Twice()
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss")).Twice()
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, 5*time.Minute).Return(nil).Once()
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, 15*time.Minute).Return(nil).Once()
+		mockClient.On("LocationKey", mock.Anything, coords).Return("nws:OKX/33,35", nil)
+		mockClient.On("GetForecastPeriods", mock.Anything, coords).Return(&ports.ForecastData{Provider: "nws"}, nil).Once()
+		mockClient.On("GetHourlyForecast", mock.Anything, coords).Return(&ports.ForecastData{Provider: "nws"}, nil).Once()
+
+		refreshed, err := service.WarmLocation(context.Background(), coords, 2*time.Minute)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, refreshed)
+		mockClient.AssertNotCalled(t, "GetForecast", mock.Anything, mock.Anything)
+		mockClient.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("reports each failed refresh", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, cfg, logger)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockClient.On("LocationKey", mock.Anything, coords).Return("nws:OKX/33,35", nil)
+		mockClient.On("GetForecast", mock.Anything, coords).Return(&ports.WeatherData{Provider: "nws"}, nil)
+		mockClient.On("GetForecastPeriods", mock.Anything, coords).Return(nil, errors.New("NWS API returned status 503"))
+		mockClient.On("GetHourlyForecast", mock.Anything, coords).Return(nil, errors.New("NWS API returned status 503"))
+
+		refreshed, err := service.WarmLocation(context.Background(), coords, time.Minute)
+
+		assert.Equal(t, 1, refreshed)
+		assert.Error(t, err)
+		assert.Len(t, err.(interface{ Unwrap() []error }).Unwrap(), 2)
+	})
+
+	t.Run("invalid coordinates", func(t *testing.T) {
+		service := NewWeatherService(new(MockWeatherClient), new(MockCacheService), nil, cfg, logger)
+
+		refreshed, err := service.WarmLocation(context.Background(), domain.Coordinates{Latitude: 91}, time.Minute)
+
+		assert.Zero(t, refreshed)
+		assert.Error(t, err)
+	})
+}
+
+// TestWeatherService_CategoryProfiles tests categorization with configured profiles.
+func TestWeatherService_CategoryProfiles(t *testing.T) {
+	logger := zap.NewNop()
+	coords := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+
+	detailed := domain.CategoryProfile{
+		Name: "detailed",
+		Bands: []domain.CategoryBand{
+			{Category: domain.Freezing, MaxFahrenheit: 32, Inclusive: true},
+			{Category: domain.Cold, MaxFahrenheit: 45},
+			{Category: domain.Cool, MaxFahrenheit: 60},
+			{Category: domain.Mild, MaxFahrenheit: 75},
+			{Category: domain.Warm, MaxFahrenheit: 85},
+			{Category: domain.Hot, MaxFahrenheit: 100},
+			{Category: domain.Extreme},
+		},
+		Adjustments: domain.CategoryAdjustments{
+			HumidityThreshold: 70,
+			HumidityOffset:    8,
+			WindThresholdMph:  20,
+			WindOffset:        -8,
+		},
+	}
+
+	cfg := Config{Profiles: []domain.CategoryProfile{detailed}}
+
+	t.Run("forecast periods use the selected profile", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, cfg, logger)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockClient.On("LocationKey", mock.Anything, mock.Anything).Return("nws:OKX/33,35", nil)
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockClient.On("GetForecastPeriods", mock.Anything, coords).Return(&ports.ForecastData{
+			Periods: []ports.PeriodData{
+				{Name: "Today", Temperature: 30, Unit: domain.Fahrenheit},
+				{Name: "Tonight", Temperature: 55, Unit: domain.Fahrenheit},
+				{Name: "Tuesday", Temperature: 104, Unit: domain.Fahrenheit},
+			},
+		}, nil)
+
+		forecast, err := service.GetForecast(context.Background(), coords, "detailed")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "detailed", forecast.Profile)
+		assert.Equal(t, domain.Freezing, forecast.Periods[0].Category)
+		assert.Equal(t, domain.Cool, forecast.Periods[1].Category)
+		assert.Equal(t, domain.Extreme, forecast.Periods[2].Category)
+	})
+
+	t.Run("observation applies humidity adjustment", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, cfg, logger)
+		humidity := 85.0
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockClient.On("GetObservation", mock.Anything, coords).Return(&ports.ObservationData{
+			StationID:        "KNYC",
+			Temperature:      domain.Temperature{Value: 80, Unit: domain.Fahrenheit},
+			RelativeHumidity: &humidity,
+		}, nil)
+
+		observation, err := service.GetObservation(context.Background(), coords, "detailed")
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Hot, observation.Category)
+		assert.Equal(t, "detailed", observation.Profile)
+	})
+
+	t.Run("configured default profile applies when none is requested", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, Config{
+			Profiles:       []domain.CategoryProfile{detailed},
+			DefaultProfile: "detailed",
+		}, logger)
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockClient.On("LocationKey", mock.Anything, mock.Anything).Return("nws:OKX/33,35", nil)
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockClient.On("GetForecast", mock.Anything, coords).
+			Return(&ports.WeatherData{Temperature: 70, Unit: domain.Fahrenheit, Forecast: "Sunny"}, nil)
+		mockClient.On("GetAlerts", mock.Anything, coords).Return([]ports.AlertData{}, nil)
+
+		weather, err := service.GetWeather(context.Background(), coords, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Mild, weather.Category)
+		assert.Equal(t, "detailed", weather.Profile)
+	})
+
+	t.Run("apparent temperature profile bands on heat index", func(t *testing.T) {
+		apparent := domain.DefaultCategoryProfile()
+		apparent.Name = "outdoor-work"
+		apparent.UseApparentTemperature = true
+
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, Config{
+			Profiles: []domain.CategoryProfile{apparent},
+		}, logger)
+		humidity := 60.0
+
+		mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("cache miss"))
+		mockClient.On("LocationKey", mock.Anything, mock.Anything).Return("nws:OKX/33,35", nil)
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockClient.On("GetForecast", mock.Anything, coords).Return(&ports.WeatherData{
+			Temperature:      84,
+			Unit:             domain.Fahrenheit,
+			RelativeHumidity: &humidity,
+		}, nil)
+		mockClient.On("GetAlerts", mock.Anything, coords).Return([]ports.AlertData{}, nil)
+
+		weather, err := service.GetWeather(context.Background(), coords, "outdoor-work")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.Temperature{Value: 87.5, Unit: domain.Fahrenheit}, weather.FeelsLike)
+		assert.Equal(t, domain.Hot, weather.Category)
+
+		weather, err = service.GetWeather(context.Background(), coords, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Moderate, weather.Category)
+	})
+
+	t.Run("apparent temperature profile ignores adjustments", func(t *testing.T) {
+		apparent := domain.DefaultCategoryProfile()
+		apparent.Name = "outdoor-work"
+		apparent.UseApparentTemperature = true
+		apparent.Adjustments = domain.CategoryAdjustments{
+			HumidityThreshold: 50,
+			HumidityOffset:    5,
+			WindThresholdMph:  10,
+			WindOffset:        -5,
+		}
+
+		muggy := 60.0
+		windy := domain.Speed{Value: 15, Unit: domain.MilesPerHour}
+
+		// A heat index of 84.4°F is moderate; adding the humidity offset on top would
+		// count the humidity twice and make it hot
+		assert.Equal(t, domain.Moderate, apparent.Categorize(domain.CategoryConditions{
+			Temperature:      domain.Temperature{Value: 82, Unit: domain.Fahrenheit},
+			RelativeHumidity: &muggy,
+		}))
+
+		// Wind chill does not apply above 50°F, and the wind offset is not applied instead
+		assert.Equal(t, domain.Moderate, apparent.Categorize(domain.CategoryConditions{
+			Temperature: domain.Temperature{Value: 52, Unit: domain.Fahrenheit},
+			WindSpeed:   &windy,
+		}))
+
+		apparent.UseApparentTemperature = false
+
+		assert.Equal(t, domain.Hot, apparent.Categorize(domain.CategoryConditions{
+			Temperature:      domain.Temperature{Value: 82, Unit: domain.Fahrenheit},
+			RelativeHumidity: &muggy,
+		}), "adjustments still apply to the air temperature")
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		mockClient := new(MockWeatherClient)
+		mockCache := new(MockCacheService)
+		service := NewWeatherService(mockClient, mockCache, nil, cfg, logger)
+
+		weather, err := service.GetWeather(context.Background(), coords, "tropical")
+
+		var weatherErr *domain.WeatherError
+
+		assert.Nil(t, weather)
+		assert.ErrorAs(t, err, &weatherErr)
+		assert.Equal(t, "INVALID_PROFILE", weatherErr.Code)
+		mockClient.AssertNotCalled(t, "GetForecast", mock.Anything, mock.Anything)
+	})
+}
+
+// TestWeatherService_FeelsLike tests heat index and wind chill against NWS reference values.
+func TestWeatherService_FeelsLike(t *testing.T) {
+	service := &weatherService{logger: zap.NewNop()}
+	humid := 70.0
+	dry := 20.0
+
+	tests := []struct {
+		name     string
+		temp     domain.Temperature
+		humidity *float64
+		wind     *domain.Wind
+		expected domain.Temperature
+	}{
+		{
+			name:     "heat index fahrenheit",
+			temp:     domain.Temperature{Value: 90, Unit: domain.Fahrenheit},
+			humidity: &humid,
+			expected: domain.Temperature{Value: 105.9, Unit: domain.Fahrenheit},
+		},
+		{
+			name:     "heat index celsius",
+			temp:     domain.Temperature{Value: 30, Unit: domain.Celsius},
+			humidity: &humid,
+			expected: domain.Temperature{Value: 35, Unit: domain.Celsius},
+		},
+		{
+			name: "wind chill fahrenheit",
+			temp: domain.Temperature{Value: 0, Unit: domain.Fahrenheit},
+			wind: &domain.Wind{
+				MinSpeed: domain.Speed{Value: 10, Unit: domain.MilesPerHour},
+				MaxSpeed: domain.Speed{Value: 15, Unit: domain.MilesPerHour},
+			},
+			expected: domain.Temperature{Value: -19.4, Unit: domain.Fahrenheit},
+		},
+		{
+			name: "wind chill celsius",
+			temp: domain.Temperature{Value: -5, Unit: domain.Celsius},
+			wind: &domain.Wind{
+				MinSpeed: domain.Speed{Value: 10, Unit: domain.KilometersPerHour},
+				MaxSpeed: domain.Speed{Value: 10, Unit: domain.KilometersPerHour},
+			},
+			expected: domain.Temperature{Value: -9.3, Unit: domain.Celsius},
+		},
+		{
+			name: "calm cold air",
+			temp: domain.Temperature{Value: 20, Unit: domain.Fahrenheit},
+			wind: &domain.Wind{
+				MinSpeed: domain.Speed{Value: 0, Unit: domain.MilesPerHour},
+				MaxSpeed: domain.Speed{Value: 2, Unit: domain.MilesPerHour},
+			},
+			expected: domain.Temperature{Value: 20, Unit: domain.Fahrenheit},
+		},
+		{
+			name:     "mild temperature",
+			temp:     domain.Temperature{Value: 70, Unit: domain.Fahrenheit},
+			humidity: &dry,
+			expected: domain.Temperature{Value: 70, Unit: domain.Fahrenheit},
+		},
+		{
+			name:     "hot without humidity",
+			temp:     domain.Temperature{Value: 95, Unit: domain.Fahrenheit},
+			expected: domain.Temperature{Value: 95, Unit: domain.Fahrenheit},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := service.feelsLike(tt.temp, tt.humidity, tt.wind)
+			assert.Equal(t, tt.expected.Unit, result.Unit)
+			assert.InDelta(t, tt.expected.Value, result.Value, 0.001)
+		})
+	}
+}
+
 // TestWeatherService_CategorizeTemperature tests temperature categorization logic.
 func TestWeatherService_CategorizeTemperature(t *testing.T) {
 	logger := zap.NewNop()
@@ -245,6 +1580,11 @@ func TestWeatherService_CategorizeTemperature(t *testing.T) {
 			temp:     domain.Temperature{Value: 20, Unit: domain.Celsius},
 			expected: domain.Moderate,
 		},
+		{
+			name:     "hot kelvin",
+			temp:     domain.Temperature{Value: 305.15, Unit: domain.Kelvin},
+			expected: domain.Hot,
+		},
 		{
 			name:     "boundary cold",
 			temp:     domain.Temperature{Value: 50, Unit: domain.Fahrenheit},
@@ -259,7 +1599,7 @@ func TestWeatherService_CategorizeTemperature(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := service.categorizeTemperature(tt.temp)
+			result := service.categorizeTemperature(domain.DefaultCategoryProfile(), tt.temp, nil, nil)
 			assert.Equal(t, tt.expected, result)
 		})
 	}
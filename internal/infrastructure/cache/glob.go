@@ -0,0 +1,135 @@
+package cache
+
+import "strings"
+
+// globEscaper backslash-escapes the bytes that are special in Redis glob patterns.
+var globEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`)
+
+// escapeGlob escapes s so that it matches only itself when used in a glob pattern.
+//
+// Parameters:
+//   - s: Literal text, such as a key prefix
+//
+// Returns:
+//   - string: Pattern matching exactly s
+func escapeGlob(s string) string {
+	return globEscaper.Replace(s)
+}
+
+// matchPattern reports whether key matches a glob pattern with the semantics of the
+// Redis KEYS and SCAN commands, so that in-memory caches delete the same keys Redis
+// would: * matches any run of bytes, ? matches one byte, [abc], [^abc] and [a-z]
+// match byte classes, and \ escapes the next byte.
+//
+// Parameters:
+//   - pattern: Glob pattern
+//   - key: Cache key to test
+//
+// Returns:
+//   - bool: Whether the whole key matches the pattern
+func matchPattern(pattern, key string) bool {
+	// Position to resume from when the last * has to absorb another byte
+	starPattern, starKey := -1, 0
+	p, k := 0, 0
+
+	for k < len(key) {
+		if p < len(pattern) {
+			switch pattern[p] {
+			case '*':
+				starPattern, starKey = p, k
+				p++
+
+				continue
+			case '?':
+				p++
+				k++
+
+				continue
+			case '[':
+				if next, ok := matchClass(pattern, p, key[k]); ok {
+					p = next
+					k++
+
+					continue
+				}
+			case '\\':
+				if p+1 < len(pattern) && pattern[p+1] == key[k] {
+					p += 2
+					k++
+
+					continue
+				}
+			default:
+				if pattern[p] == key[k] {
+					p++
+					k++
+
+					continue
+				}
+			}
+		}
+
+		if starPattern < 0 {
+			return false
+		}
+
+		starKey++
+		p, k = starPattern+1, starKey
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
+
+// matchClass matches one byte against the [...] class starting at pattern[start].
+// An unterminated class extends to the end of the pattern, as in Redis.
+//
+// Parameters:
+//   - pattern: Glob pattern
+//   - start: Index of the opening [
+//   - c: Byte to match
+//
+// Returns:
+//   - int: Index just past the class
+//   - bool: Whether c belongs to the class
+func matchClass(pattern string, start int, c byte) (int, bool) {
+	i := start + 1
+	negate := i < len(pattern) && pattern[i] == '^'
+
+	if negate {
+		i++
+	}
+
+	matched := false
+
+	for i < len(pattern) && pattern[i] != ']' {
+		switch {
+		case pattern[i] == '\\' && i+1 < len(pattern):
+			i++
+			matched = matched || pattern[i] == c
+		case i+2 < len(pattern) && pattern[i+1] == '-' && pattern[i+2] != ']':
+			lo, hi := pattern[i], pattern[i+2]
+
+			if lo > hi {
+				lo, hi = hi, lo
+			}
+
+			matched = matched || (c >= lo && c <= hi)
+			i += 2
+		default:
+			matched = matched || pattern[i] == c
+		}
+
+		i++
+	}
+
+	if i < len(pattern) {
+		// Skip the closing ]
+		i++
+	}
+
+	return i, matched != negate
+}
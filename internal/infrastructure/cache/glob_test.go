@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMatchPattern tests glob matching against the Redis pattern syntax.
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		key     string
+		want    bool
+	}{
+		{"*", "", true},
+		{"*", "weather:abc", true},
+		{"weather:*", "weather:abc", true},
+		{"weather:*", "forecast:abc", false},
+		{"weather:*", "lock:weather:abc", false},
+		{"*:abc", "hourly:abc", true},
+		{"h?llo", "hello", true},
+		{"h?llo", "hllo", false},
+		{"h[ae]llo", "hallo", true},
+		{"h[ae]llo", "hillo", false},
+		{"h[^e]llo", "hallo", true},
+		{"h[^e]llo", "hello", false},
+		{"h[a-c]llo", "hbllo", true},
+		{"h[a-c]llo", "hdllo", false},
+		{`h\*llo`, "h*llo", true},
+		{`h\*llo`, "hello", false},
+		{"a*b*c", "axxbyyc", true},
+		{"a*b*c", "axxbyy", false},
+		{"nws:points:*", "nws:points:40.7128,-74.0060", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.key, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchPattern(tt.pattern, tt.key))
+		})
+	}
+}
+
+// TestEscapeGlob tests that escaped text matches only itself.
+func TestEscapeGlob(t *testing.T) {
+	for _, literal := range []string{"cache", "a*b", "a?b", "a[bc]d", `a\b`, `*?[\`} {
+		t.Run(literal, func(t *testing.T) {
+			pattern := escapeGlob(literal)
+
+			assert.True(t, matchPattern(pattern, literal))
+			assert.False(t, matchPattern(pattern, literal+"x"))
+			assert.False(t, matchPattern(pattern, "x"+literal[1:]))
+		})
+	}
+}
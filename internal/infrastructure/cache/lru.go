@@ -0,0 +1,336 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Reasons reported when an entry leaves an lruCache other than by delete or clear.
+const (
+	evictCapacity = "capacity"
+	evictExpired  = "expired"
+)
+
+// lruCache is an in-memory cache bounded by both an entry count and a byte budget,
+// whose entries expire after a TTL. When either budget is exceeded it evicts the
+// least recently used entries. Expired entries are dropped when they are read or
+// reach the end of the eviction order.
+type lruCache struct {
+	// mu guards every field below
+	mu sync.Mutex
+
+	// maxEntries bounds the number of entries held
+	maxEntries int
+
+	// maxBytes bounds the total size of the keys and values held
+	maxBytes int64
+
+	// bytes is the total size of the keys and values held
+	bytes int64
+
+	// order lists entries from most to least recently used
+	order *list.List
+
+	// items indexes the entries of order by key
+	items map[string]*list.Element
+
+	// seq counts writes; every set, delete or clear takes the next value
+	seq uint64
+
+	// fills counts the reads of a slower tier in progress for each key
+	fills map[string]int
+
+	// written holds the seq of the last set or delete of each key with a fill in progress
+	written map[string]uint64
+
+	// flushed is the seq of the last clear or pattern delete, which cancels every fill in progress
+	flushed uint64
+
+	// onEvict, when set, is called with the reason each time an entry is evicted
+	onEvict func(reason string)
+}
+
+// lruEntry is one cached value.
+type lruEntry struct {
+	key   string
+	value []byte
+
+	// expires is when the entry expires; zero if it never does
+	expires time.Time
+}
+
+// size returns the number of bytes the entry counts against the byte budget.
+//
+// Returns:
+//   - int64: Combined size of key and value
+func (e *lruEntry) size() int64 {
+	return int64(len(e.key) + len(e.value))
+}
+
+// expired reports whether the entry has expired at now.
+//
+// Parameters:
+//   - now: Time to check against
+//
+// Returns:
+//   - bool: Whether the entry has an expiry that has passed
+func (e *lruEntry) expired(now time.Time) bool {
+	return !e.expires.IsZero() && now.After(e.expires)
+}
+
+// newLRUCache creates an empty LRU cache.
+//
+// Parameters:
+//   - maxEntries: Maximum number of entries held
+//   - maxBytes: Maximum total size of the keys and values held
+//
+// Returns:
+//   - *lruCache: Empty cache
+func newLRUCache(maxEntries int, maxBytes int64) *lruCache {
+	return &lruCache{
+		maxEntries: maxEntries,
+		maxBytes:   maxBytes,
+		order:      list.New(),
+		items:      make(map[string]*list.Element),
+		fills:      make(map[string]int),
+		written:    make(map[string]uint64),
+	}
+}
+
+// get returns the unexpired value for key and marks it as recently used.
+//
+// Parameters:
+//   - key: Cache key to look up
+//
+// Returns:
+//   - []byte: Cached value
+//   - bool: Whether an unexpired value was found
+func (c *lruCache) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*lruEntry)
+
+	if entry.expired(time.Now()) {
+		c.remove(elem)
+		c.evicted(evictExpired)
+
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+
+	return entry.value, true
+}
+
+// set stores value under key for ttl, evicting least recently used entries until
+// both budgets are met. A value larger than the whole byte budget is not stored.
+//
+// Parameters:
+//   - key: Cache key
+//   - value: Data to cache
+//   - ttl: Time-to-live for the entry; zero or less never expires
+func (c *lruCache) set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// A read of a slower tier that started before this write must not replace it with an older value
+	c.wrote(key)
+	c.store(key, value, ttl)
+}
+
+// beginFill records that the value for key is being read from a slower tier, so that
+// a set or delete of the key before endFill cancels caching the read value.
+//
+// Parameters:
+//   - key: Cache key being read
+//
+// Returns:
+//   - uint64: Write sequence to pass to endFill
+func (c *lruCache) beginFill(key string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.fills[key]++
+
+	return c.seq
+}
+
+// endFill finishes a fill started with beginFill. The value is stored unless the key
+// was set or deleted, or the cache cleared, since the fill began; writes to other
+// keys do not affect it.
+//
+// Parameters:
+//   - key: Cache key that was read
+//   - since: Write sequence returned by beginFill
+//   - value: Value read from the slower tier; nil stores nothing
+//   - ttl: Time-to-live for the entry
+func (c *lruCache) endFill(key string, since uint64, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if value != nil && c.written[key] <= since && c.flushed <= since {
+		c.store(key, value, ttl)
+	}
+
+	if c.fills[key]--; c.fills[key] <= 0 {
+		delete(c.fills, key)
+		delete(c.written, key)
+	}
+}
+
+// wrote records a set or delete of key for the fills in progress. The caller must hold mu.
+//
+// Parameters:
+//   - key: Cache key written
+func (c *lruCache) wrote(key string) {
+	c.seq++
+
+	if c.fills[key] > 0 {
+		c.written[key] = c.seq
+	}
+}
+
+// flush records a clear or pattern delete, which cancels every fill in progress.
+// The caller must hold mu.
+func (c *lruCache) flush() {
+	c.seq++
+	c.flushed = c.seq
+}
+
+// store inserts or replaces an entry and evicts entries over budget. The caller must hold mu.
+//
+// Parameters:
+//   - key: Cache key
+//   - value: Data to cache
+//   - ttl: Time-to-live for the entry; zero or less never expires
+func (c *lruCache) store(key string, value []byte, ttl time.Duration) {
+	entry := &lruEntry{key: key, value: value}
+
+	if ttl > 0 {
+		entry.expires = time.Now().Add(ttl)
+	}
+
+	if elem, ok := c.items[key]; ok {
+		c.remove(elem)
+	}
+
+	if entry.size() > c.maxBytes {
+		c.evicted(evictCapacity)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(entry)
+	c.bytes += entry.size()
+
+	for c.order.Len() > c.maxEntries || c.bytes > c.maxBytes {
+		oldest := c.order.Back()
+		reason := evictCapacity
+
+		if oldest.Value.(*lruEntry).expired(time.Now()) {
+			reason = evictExpired
+		}
+
+		c.remove(oldest)
+		c.evicted(reason)
+	}
+}
+
+// delete removes the entry for key, if any.
+//
+// Parameters:
+//   - key: Cache key to remove
+//
+// Returns:
+//   - bool: Whether an entry was removed
+func (c *lruCache) delete(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.wrote(key)
+
+	elem, ok := c.items[key]
+
+	if ok {
+		c.remove(elem)
+	}
+
+	return ok
+}
+
+// deleteMatching removes the entries whose keys match a glob pattern.
+//
+// Parameters:
+//   - pattern: Glob pattern in Redis syntax
+//
+// Returns:
+//   - int64: Number of entries removed
+func (c *lruCache) deleteMatching(pattern string) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.flush()
+
+	var deleted int64
+
+	for key, elem := range c.items {
+		if matchPattern(pattern, key) {
+			c.remove(elem)
+			deleted++
+		}
+	}
+
+	return deleted
+}
+
+// clear removes every entry.
+func (c *lruCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.flush()
+	c.order.Init()
+	c.items = make(map[string]*list.Element)
+	c.bytes = 0
+}
+
+// usage returns how much of each budget is in use.
+//
+// Returns:
+//   - int: Number of entries held
+//   - int64: Total size of the keys and values held
+func (c *lruCache) usage() (int, int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len(), c.bytes
+}
+
+// remove unlinks an entry. The caller must hold mu.
+//
+// Parameters:
+//   - elem: Element of order holding the entry
+func (c *lruCache) remove(elem *list.Element) {
+	entry := elem.Value.(*lruEntry)
+
+	c.order.Remove(elem)
+	delete(c.items, entry.key)
+	c.bytes -= entry.size()
+}
+
+// evicted reports an eviction to onEvict. The caller must hold mu.
+//
+// Parameters:
+//   - reason: Why the entry was evicted
+func (c *lruCache) evicted(reason string) {
+	if c.onEvict != nil {
+		c.onEvict(reason)
+	}
+}
@@ -6,34 +6,146 @@ import (
 	"context"
 	"time"
 
-	gocache "github.com/patrickmn/go-cache"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 
 	"github.com/sean-rowe/weather-service/internal/core/ports"
 )
 
-// MemoryCache provides an in-memory cache implementation using go-cache.
+// Memory cache defaults applied when MemoryConfig leaves a setting at zero.
+const (
+	DefaultMemoryMaxEntries = 10000
+	DefaultMemoryMaxBytes   = 64 << 20
+	defaultMemoryName       = "memory"
+)
+
+// MemoryConfig holds the budgets of an in-memory cache.
+// Zero values are replaced with sensible defaults.
+type MemoryConfig struct {
+	// MaxEntries bounds the number of entries held (default: 10000)
+	MaxEntries int
+
+	// MaxBytes bounds the total size of the keys and values held (default: 64 MiB)
+	MaxBytes int64
+
+	// Name identifies the cache in the cache attribute of its metrics (default: memory)
+	Name string
+
+	// Meter records eviction and size metrics; nil uses the global meter provider
+	Meter metric.Meter
+}
+
+// MemoryCache provides a bounded in-memory cache. When either its entry or byte
+// budget is exceeded it evicts the least recently used entries, so memory use
+// stays bounded however many distinct keys are requested.
 type MemoryCache struct {
-	cache  *gocache.Cache
+	// lru holds the cached entries
+	lru *lruCache
+
+	// name is the cache attribute recorded with metrics
+	name attribute.KeyValue
+
+	// logger records cache operations
 	logger *zap.Logger
 }
 
-// NewMemoryCache creates a new in-memory cache with specified TTL and cleanup intervals.
+// NewMemoryCache creates a bounded in-memory cache and registers its metrics:
+// evictions by reason, and the current number of entries and bytes held.
 //
 // Parameters:
-//   - defaultTTL: Default time-to-live for cached items
-//   - cleanupInterval: How often to clean up expired items
+//   - cfg: Entry and byte budgets
 //   - logger: Zap logger for cache operations
 //
 // Returns:
 //   - ports.CacheService: In-memory cache implementation
-func NewMemoryCache(defaultTTL, cleanupInterval time.Duration, logger *zap.Logger) ports.CacheService {
-	return &MemoryCache{
-		cache:  gocache.New(defaultTTL, cleanupInterval),
+func NewMemoryCache(cfg MemoryConfig, logger *zap.Logger) ports.CacheService {
+	return newMemoryCache(cfg, logger)
+}
+
+// newMemoryCache creates a bounded in-memory cache for callers in this package that
+// need its concrete type.
+//
+// Parameters:
+//   - cfg: Entry and byte budgets
+//   - logger: Zap logger for cache operations
+//
+// Returns:
+//   - *MemoryCache: In-memory cache
+func newMemoryCache(cfg MemoryConfig, logger *zap.Logger) *MemoryCache {
+	if cfg.MaxEntries <= 0 {
+		cfg.MaxEntries = DefaultMemoryMaxEntries
+	}
+
+	if cfg.MaxBytes <= 0 {
+		cfg.MaxBytes = DefaultMemoryMaxBytes
+	}
+
+	if cfg.Name == "" {
+		cfg.Name = defaultMemoryName
+	}
+
+	meter := cfg.Meter
+
+	if meter == nil {
+		meter = otel.Meter(instrumentationName)
+	}
+
+	m := &MemoryCache{
+		lru:    newLRUCache(cfg.MaxEntries, cfg.MaxBytes),
+		name:   attribute.String("cache", cfg.Name),
 		logger: logger,
 	}
+
+	// Instrument constructors return a usable no-op instrument alongside any error
+	evictions, err := meter.Int64Counter(
+		"cache_evictions_total",
+		metric.WithDescription("Total number of entries evicted from in-memory caches"),
+		metric.WithUnit("1"),
+	)
+
+	if err != nil {
+		logger.Warn("failed to create cache eviction counter", zap.Error(err))
+	}
+
+	m.lru.onEvict = func(reason string) {
+		evictions.Add(context.Background(), 1, metric.WithAttributes(m.name, attribute.String("reason", reason)))
+	}
+
+	entries, err := meter.Int64ObservableGauge(
+		"cache_entries",
+		metric.WithDescription("Number of entries held by in-memory caches"),
+		metric.WithUnit("1"),
+	)
+
+	if err != nil {
+		logger.Warn("failed to create cache entries gauge", zap.Error(err))
+	}
+
+	size, err := meter.Int64ObservableGauge(
+		"cache_size_bytes",
+		metric.WithDescription("Total size of the keys and values held by in-memory caches"),
+		metric.WithUnit("By"),
+	)
+
+	if err != nil {
+		logger.Warn("failed to create cache size gauge", zap.Error(err))
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		count, bytes := m.lru.usage()
+		o.ObserveInt64(entries, int64(count), metric.WithAttributes(m.name))
+		o.ObserveInt64(size, bytes, metric.WithAttributes(m.name))
+
+		return nil
+	}, entries, size)
+
+	if err != nil {
+		logger.Warn("failed to register cache size callback", zap.Error(err))
+	}
+
+	return m
 }
 
 // Get retrieves a value from the cache by key.
@@ -44,7 +156,7 @@ func NewMemoryCache(defaultTTL, cleanupInterval time.Duration, logger *zap.Logge
 //
 // Returns:
 //   - []byte: Cached value if found
-//   - error: ErrCacheMiss if key is not found
+//   - error: ErrCacheMiss if key is not found or has expired
 func (m *MemoryCache) Get(ctx context.Context, key string) ([]byte, error) {
 	tracer := otel.Tracer("cache")
 	_, span := tracer.Start(ctx, "MemoryCache.Get")
@@ -53,11 +165,11 @@ func (m *MemoryCache) Get(ctx context.Context, key string) ([]byte, error) {
 
 	span.SetAttributes(attribute.String("cache.key", key))
 
-	if value, found := m.cache.Get(key); found {
+	if value, found := m.lru.get(key); found {
 		span.SetAttributes(attribute.Bool("cache.hit", true))
 		m.logger.Debug("memory cache hit", zap.String("key", key))
 
-		return value.([]byte), nil
+		return value, nil
 	}
 
 	span.SetAttributes(attribute.Bool("cache.hit", false))
@@ -66,13 +178,14 @@ func (m *MemoryCache) Get(ctx context.Context, key string) ([]byte, error) {
 	return nil, ErrCacheMiss
 }
 
-// Set stores a value in the cache with the specified TTL.
+// Set stores a value in the cache with the specified TTL, evicting the least
+// recently used entries if the cache is over budget.
 //
 // Parameters:
 //   - ctx: Context for tracing
 //   - key: Cache key to store under
 //   - value: Data to cache
-//   - ttl: Time-to-live for this cache entry
+//   - ttl: Time-to-live for this cache entry; zero or less never expires
 //
 // Returns:
 //   - error: Always nil for in-memory cache
@@ -88,7 +201,7 @@ func (m *MemoryCache) Set(ctx context.Context, key string, value []byte, ttl tim
 		attribute.String("cache.ttl", ttl.String()),
 	)
 
-	m.cache.Set(key, value, ttl)
+	m.lru.set(key, value, ttl)
 	m.logger.Debug("memory cache set", zap.String("key", key))
 
 	return nil
@@ -109,7 +222,7 @@ func (m *MemoryCache) Delete(ctx context.Context, key string) error {
 	defer span.End()
 
 	span.SetAttributes(attribute.String("cache.key", key))
-	m.cache.Delete(key)
+	m.lru.delete(key)
 	m.logger.Debug("memory cache delete", zap.String("key", key))
 
 	return nil
@@ -128,8 +241,34 @@ func (m *MemoryCache) Clear(ctx context.Context) error {
 
 	defer span.End()
 
-	m.cache.Flush()
+	m.lru.clear()
 	m.logger.Info("memory cache cleared")
 
 	return nil
 }
+
+// DeletePattern removes every value whose key matches a glob pattern, using the same
+// pattern syntax as Redis.
+//
+// Parameters:
+//   - ctx: Context for tracing
+//   - pattern: Glob pattern such as "weather:*"
+//
+// Returns:
+//   - int64: Number of keys deleted
+//   - error: Always nil for in-memory cache
+func (m *MemoryCache) DeletePattern(ctx context.Context, pattern string) (int64, error) {
+	tracer := otel.Tracer("cache")
+	_, span := tracer.Start(ctx, "MemoryCache.DeletePattern")
+
+	defer span.End()
+
+	span.SetAttributes(attribute.String("cache.pattern", pattern))
+
+	deleted := m.lru.deleteMatching(pattern)
+
+	span.SetAttributes(attribute.Int64("cache.deleted", deleted))
+	m.logger.Debug("memory cache delete pattern", zap.String("pattern", pattern), zap.Int64("deleted", deleted))
+
+	return deleted, nil
+}
@@ -0,0 +1,170 @@
+package cache
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.uber.org/zap"
+)
+
+// collectMemoryMetrics reads the memory cache metrics from a manual reader. Evictions
+// are keyed by reason, such as "cache_evictions_total/capacity", and gauges by name.
+func collectMemoryMetrics(t *testing.T, reader *sdkmetric.ManualReader) map[string]int64 {
+	t.Helper()
+
+	var metrics metricdata.ResourceMetrics
+
+	assert.NoError(t, reader.Collect(context.Background(), &metrics))
+
+	values := map[string]int64{}
+
+	for _, scope := range metrics.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, point := range data.DataPoints {
+					reason, _ := point.Attributes.Value(attribute.Key("reason"))
+					values[m.Name+"/"+reason.AsString()] += point.Value
+				}
+			case metricdata.Gauge[int64]:
+				for _, point := range data.DataPoints {
+					values[m.Name] = point.Value
+				}
+			}
+		}
+	}
+
+	return values
+}
+
+// TestMemoryCache tests the entry and byte budgets, LRU eviction and metrics of the memory cache.
+func TestMemoryCache(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	t.Run("stores and expires values", func(t *testing.T) {
+		cache := NewMemoryCache(MemoryConfig{}, logger)
+
+		assert.NoError(t, cache.Set(ctx, "key", []byte("value"), 20*time.Millisecond))
+		assert.NoError(t, cache.Set(ctx, "forever", []byte("value"), 0))
+
+		value, err := cache.Get(ctx, "key")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("value"), value)
+
+		time.Sleep(40 * time.Millisecond)
+
+		_, err = cache.Get(ctx, "key")
+		assert.ErrorIs(t, err, ErrCacheMiss)
+
+		_, err = cache.Get(ctx, "forever")
+		assert.NoError(t, err, "a zero TTL never expires")
+	})
+
+	t.Run("evicts the least recently used entry over the entry budget", func(t *testing.T) {
+		cache := NewMemoryCache(MemoryConfig{MaxEntries: 2}, logger)
+
+		assert.NoError(t, cache.Set(ctx, "a", []byte("1"), time.Minute))
+		assert.NoError(t, cache.Set(ctx, "b", []byte("2"), time.Minute))
+		_, _ = cache.Get(ctx, "a")
+		assert.NoError(t, cache.Set(ctx, "c", []byte("3"), time.Minute))
+
+		_, err := cache.Get(ctx, "b")
+		assert.ErrorIs(t, err, ErrCacheMiss, "the least recently used entry is evicted")
+
+		for _, key := range []string{"a", "c"} {
+			_, err := cache.Get(ctx, key)
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("evicts until the byte budget is met", func(t *testing.T) {
+		cache := newMemoryCache(MemoryConfig{MaxBytes: 100}, logger)
+		value := []byte(strings.Repeat("x", 39))
+
+		assert.NoError(t, cache.Set(ctx, "a", value, time.Minute))
+		assert.NoError(t, cache.Set(ctx, "b", value, time.Minute))
+		assert.NoError(t, cache.Set(ctx, "c", value, time.Minute))
+
+		entries, bytes := cache.lru.usage()
+		assert.Equal(t, 2, entries)
+		assert.Equal(t, int64(80), bytes)
+
+		_, err := cache.Get(ctx, "a")
+		assert.ErrorIs(t, err, ErrCacheMiss)
+
+		assert.NoError(t, cache.Set(ctx, "huge", make([]byte, 200), time.Minute))
+
+		_, err = cache.Get(ctx, "huge")
+		assert.ErrorIs(t, err, ErrCacheMiss, "a value larger than the budget is not stored")
+
+		_, err = cache.Get(ctx, "c")
+		assert.NoError(t, err, "an oversized value does not evict other entries")
+	})
+
+	t.Run("replacing a value updates its size", func(t *testing.T) {
+		cache := newMemoryCache(MemoryConfig{}, logger)
+
+		assert.NoError(t, cache.Set(ctx, "key", make([]byte, 10), time.Minute))
+		assert.NoError(t, cache.Set(ctx, "key", make([]byte, 30), time.Minute))
+
+		entries, bytes := cache.lru.usage()
+		assert.Equal(t, 1, entries)
+		assert.Equal(t, int64(33), bytes)
+
+		assert.NoError(t, cache.Delete(ctx, "key"))
+
+		entries, bytes = cache.lru.usage()
+		assert.Equal(t, 0, entries)
+		assert.Equal(t, int64(0), bytes)
+	})
+
+	t.Run("deletes by pattern and clears", func(t *testing.T) {
+		cache := NewMemoryCache(MemoryConfig{}, logger)
+
+		for _, key := range []string{"weather:a", "weather:b", "forecast:a"} {
+			assert.NoError(t, cache.Set(ctx, key, []byte("v"), time.Minute))
+		}
+
+		deleted, err := cache.DeletePattern(ctx, "weather:*")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), deleted)
+
+		_, err = cache.Get(ctx, "forecast:a")
+		assert.NoError(t, err)
+
+		assert.NoError(t, cache.Clear(ctx))
+
+		_, err = cache.Get(ctx, "forecast:a")
+		assert.ErrorIs(t, err, ErrCacheMiss)
+	})
+
+	t.Run("records evictions and current size", func(t *testing.T) {
+		reader := sdkmetric.NewManualReader()
+		meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+		cache := NewMemoryCache(MemoryConfig{MaxEntries: 2, Meter: meter}, logger)
+
+		assert.NoError(t, cache.Set(ctx, "a", []byte("1"), time.Minute))
+		assert.NoError(t, cache.Set(ctx, "b", []byte("2"), time.Minute))
+		assert.NoError(t, cache.Set(ctx, "c", []byte("3"), time.Minute))
+		assert.NoError(t, cache.Set(ctx, "d", []byte("4"), time.Minute))
+
+		assert.NoError(t, cache.Set(ctx, "c", []byte("3"), 10*time.Millisecond))
+		time.Sleep(20 * time.Millisecond)
+
+		_, err := cache.Get(ctx, "c")
+		assert.ErrorIs(t, err, ErrCacheMiss)
+
+		values := collectMemoryMetrics(t, reader)
+		assert.Equal(t, int64(2), values["cache_evictions_total/capacity"])
+		assert.Equal(t, int64(1), values["cache_evictions_total/expired"])
+		assert.Equal(t, int64(1), values["cache_entries"])
+		assert.Equal(t, int64(2), values["cache_size_bytes"])
+	})
+}
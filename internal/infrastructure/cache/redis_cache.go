@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
@@ -20,13 +21,24 @@ import (
 // It provides persistent, scalable caching across multiple service instances
 // with OpenTelemetry tracing for cache operations.
 type RedisCache struct {
-	client *redis.Client
-	logger *zap.Logger
+	client    *redis.Client
+	namespace string
+	logger    *zap.Logger
 }
 
+// errNoNamespace is returned by Clear when the cache has no namespace, since clearing
+// would then delete every key in the Redis database.
+var errNoNamespace = errors.New("cache has no namespace; refusing to clear the whole Redis database")
+
+// scanBatchSize is the number of keys requested per SCAN call when deleting by pattern.
+const scanBatchSize = 500
+
 // Config holds Redis connection and performance settings.
 // These settings control connection pooling, timeouts, and reliability.
+// Namespace, when set, prefixes every key as "<namespace>:<key>" so that the cache
+// can share a Redis database with rate limiting and other services. Clear requires it.
 type Config struct {
+	Namespace    string
 	Addr         string
 	Password     string
 	DB           int
@@ -68,11 +80,43 @@ func NewRedisCache(cfg Config, logger *zap.Logger) (ports.CacheService, error) {
 	}
 
 	return &RedisCache{
-		client: rdb,
-		logger: logger,
+		client:    rdb,
+		namespace: cfg.Namespace,
+		logger:    logger,
 	}, nil
 }
 
+// key returns the Redis key for a cache key, prefixed with the namespace.
+//
+// Parameters:
+//   - key: Cache key
+//
+// Returns:
+//   - string: Namespaced Redis key
+func (r *RedisCache) key(key string) string {
+	if r.namespace == "" {
+		return key
+	}
+
+	return r.namespace + ":" + key
+}
+
+// pattern returns the Redis glob pattern for a cache key pattern, prefixed with the
+// namespace. The namespace is escaped, so glob characters in it match only themselves.
+//
+// Parameters:
+//   - pattern: Glob pattern over cache keys
+//
+// Returns:
+//   - string: Namespaced Redis glob pattern
+func (r *RedisCache) pattern(pattern string) string {
+	if r.namespace == "" {
+		return pattern
+	}
+
+	return escapeGlob(r.namespace) + ":" + pattern
+}
+
 // Get retrieves a value from Redis cache.
 //
 // Parameters:
@@ -90,7 +134,7 @@ func (r *RedisCache) Get(ctx context.Context, key string) ([]byte, error) {
 
 	span.SetAttributes(attribute.String("cache.key", key))
 	start := time.Now()
-	result, err := r.client.Get(ctx, key).Bytes()
+	result, err := r.client.Get(ctx, r.key(key)).Bytes()
 	duration := time.Since(start)
 
 	if errors.Is(err, redis.Nil) {
@@ -145,7 +189,7 @@ func (r *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time
 	)
 
 	start := time.Now()
-	err := r.client.Set(ctx, key, value, ttl).Err()
+	err := r.client.Set(ctx, r.key(key), value, ttl).Err()
 	duration := time.Since(start)
 
 	if err != nil {
@@ -181,7 +225,7 @@ func (r *RedisCache) Delete(ctx context.Context, key string) error {
 
 	span.SetAttributes(attribute.String("cache.key", key))
 	start := time.Now()
-	err := r.client.Del(ctx, key).Err()
+	err := r.client.Del(ctx, r.key(key)).Err()
 	duration := time.Since(start)
 
 	if err != nil {
@@ -201,21 +245,31 @@ func (r *RedisCache) Delete(ctx context.Context, key string) error {
 	return nil
 }
 
-// Clear flushes all values from the Redis database.
+// Clear removes every value in the cache namespace. Keys are deleted in SCAN batches,
+// so Redis is never blocked and keys outside the namespace, such as rate limit
+// counters, are left alone. A cache without a namespace is not cleared, since that
+// would delete every key in the database.
 //
 // Parameters:
 //   - ctx: Context for cancellation and tracing
 //
 // Returns:
-//   - error: Redis flush error if operation fails
+//   - error: errNoNamespace without a namespace, or Redis scan or delete error if operation fails
 func (r *RedisCache) Clear(ctx context.Context) error {
 	tracer := otel.Tracer("cache")
 	ctx, span := tracer.Start(ctx, "Cache.Clear")
 
 	defer span.End()
 
+	if r.namespace == "" {
+		span.RecordError(errNoNamespace)
+		r.logger.Error("cache clear refused", zap.Error(errNoNamespace))
+
+		return errNoNamespace
+	}
+
 	start := time.Now()
-	err := r.client.FlushDB(ctx).Err()
+	deleted, err := r.deleteMatching(ctx, r.pattern("*"))
 	duration := time.Since(start)
 
 	if err != nil {
@@ -225,7 +279,169 @@ func (r *RedisCache) Clear(ctx context.Context) error {
 		return err
 	}
 
-	r.logger.Info("cache cleared", zap.Duration("duration", duration))
+	span.SetAttributes(attribute.Int64("cache.deleted", deleted))
+
+	r.logger.Info("cache cleared",
+		zap.String("namespace", r.namespace),
+		zap.Int64("deleted", deleted),
+		zap.Duration("duration", duration))
+
+	return nil
+}
+
+// DeletePattern removes every value in the cache namespace whose key matches a glob
+// pattern. The pattern is matched against keys without the namespace prefix.
+//
+// Parameters:
+//   - ctx: Context for cancellation and tracing
+//   - pattern: Glob pattern such as "weather:*"
+//
+// Returns:
+//   - int64: Number of keys deleted
+//   - error: Redis scan or delete error if operation fails
+func (r *RedisCache) DeletePattern(ctx context.Context, pattern string) (int64, error) {
+	tracer := otel.Tracer("cache")
+	ctx, span := tracer.Start(ctx, "Cache.DeletePattern")
+
+	defer span.End()
+
+	span.SetAttributes(attribute.String("cache.pattern", pattern))
+	start := time.Now()
+	deleted, err := r.deleteMatching(ctx, r.pattern(pattern))
+	duration := time.Since(start)
+
+	if err != nil {
+		span.RecordError(err)
+
+		r.logger.Error("cache delete pattern error",
+			zap.String("pattern", pattern),
+			zap.Error(err))
+
+		return deleted, err
+	}
+
+	span.SetAttributes(attribute.Int64("cache.deleted", deleted))
+
+	r.logger.Debug("cache delete pattern",
+		zap.String("pattern", pattern),
+		zap.Int64("deleted", deleted),
+		zap.Duration("duration", duration))
+
+	return deleted, nil
+}
+
+// deleteMatching scans for keys matching a Redis pattern and unlinks them one batch
+// at a time.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - match: Namespaced Redis glob pattern
+//
+// Returns:
+//   - int64: Number of keys deleted before any error
+//   - error: Redis scan or delete error
+func (r *RedisCache) deleteMatching(ctx context.Context, match string) (int64, error) {
+	var cursor uint64
+	var deleted int64
+
+	for {
+		keys, next, err := r.client.Scan(ctx, cursor, match, scanBatchSize).Result()
+
+		if err != nil {
+			return deleted, err
+		}
+
+		if len(keys) > 0 {
+			n, err := r.client.Unlink(ctx, keys...).Result()
+
+			if err != nil {
+				return deleted, err
+			}
+
+			deleted += n
+		}
+
+		if next == 0 {
+			return deleted, nil
+		}
+
+		cursor = next
+	}
+}
+
+// unlockScript deletes a lock only if it still holds the caller's token, so a holder
+// whose lock expired cannot release a lock since acquired by another instance.
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// TryLock acquires a lock shared by every instance using this Redis database.
+// The lock expires after ttl even if it is never released.
+//
+// Parameters:
+//   - ctx: Context for cancellation and tracing
+//   - key: Lock name
+//   - ttl: Maximum time the lock is held
+//
+// Returns:
+//   - string: Token to pass to Unlock
+//   - bool: Whether the lock was acquired
+//   - error: Redis error if the lock state is unknown
+func (r *RedisCache) TryLock(ctx context.Context, key string, ttl time.Duration) (string, bool, error) {
+	tracer := otel.Tracer("cache")
+	ctx, span := tracer.Start(ctx, "Cache.TryLock")
+
+	defer span.End()
+
+	span.SetAttributes(attribute.String("cache.key", key))
+	token := uuid.NewString()
+	acquired, err := r.client.SetNX(ctx, r.key(key), token, ttl).Result()
+
+	if err != nil {
+		span.RecordError(err)
+
+		r.logger.Error("cache lock error",
+			zap.String("key", key),
+			zap.Error(err))
+
+		return "", false, err
+	}
+
+	span.SetAttributes(attribute.Bool("cache.lock_acquired", acquired))
+
+	return token, acquired, nil
+}
+
+// Unlock releases a lock acquired with TryLock. Locks that have expired or
+// were taken over by another holder are left alone.
+//
+// Parameters:
+//   - ctx: Context for cancellation and tracing
+//   - key: Lock name
+//   - token: Token returned by TryLock
+//
+// Returns:
+//   - error: Redis error if the lock could not be released
+func (r *RedisCache) Unlock(ctx context.Context, key, token string) error {
+	tracer := otel.Tracer("cache")
+	ctx, span := tracer.Start(ctx, "Cache.Unlock")
+
+	defer span.End()
+
+	span.SetAttributes(attribute.String("cache.key", key))
+
+	if err := unlockScript.Run(ctx, r.client, []string{r.key(key)}, token).Err(); err != nil {
+		span.RecordError(err)
+
+		r.logger.Error("cache unlock error",
+			zap.String("key", key),
+			zap.Error(err))
+
+		return err
+	}
 
 	return nil
 }
@@ -0,0 +1,29 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// TestRedisCache_Namespace tests that namespace patterns cannot reach keys outside the
+// namespace and that a cache without one is never cleared.
+func TestRedisCache_Namespace(t *testing.T) {
+	t.Run("glob characters in the namespace are escaped", func(t *testing.T) {
+		r := &RedisCache{namespace: "cache[1]*"}
+		pattern := r.pattern("weather:*")
+
+		assert.Equal(t, `cache\[1]\*:weather:*`, pattern)
+		assert.True(t, matchPattern(pattern, "cache[1]*:weather:abc"))
+		assert.False(t, matchPattern(pattern, "cache1:weather:abc"))
+		assert.False(t, matchPattern(pattern, "cache[1]-ratelimit:weather:abc"))
+	})
+
+	t.Run("clear without a namespace is refused", func(t *testing.T) {
+		r := &RedisCache{logger: zap.NewNop()}
+
+		assert.ErrorIs(t, r.Clear(context.Background()), errNoNamespace)
+	})
+}
@@ -0,0 +1,467 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// instrumentationName names the meter used when TieredConfig.Meter is not set.
+const instrumentationName = "github.com/sean-rowe/weather-service/internal/infrastructure/cache"
+
+// Tiered cache defaults applied when TieredConfig leaves a setting at zero.
+const (
+	DefaultL1TTL               = 30 * time.Second
+	DefaultL1MaxEntries        = 10000
+	DefaultL1MaxBytes          = 16 << 20
+	DefaultInvalidationChannel = "cache:invalidate"
+)
+
+// Cache tiers reported in the tier attribute of the hit and miss counters.
+var (
+	tierL1 = metric.WithAttributes(attribute.String("tier", "l1"))
+	tierL2 = metric.WithAttributes(attribute.String("tier", "l2"))
+)
+
+// errNoLocking is returned by TryLock and Unlock when the L2 cache cannot take locks.
+var errNoLocking = errors.New("cache: L2 cache does not support locking")
+
+// TieredConfig holds settings for a two-tier cache.
+// Zero values are replaced with sensible defaults.
+type TieredConfig struct {
+	// L1TTL caps how long an entry is served from process memory (default: 30s)
+	L1TTL time.Duration
+
+	// L1MaxEntries bounds the number of entries held in process memory (default: 10000)
+	L1MaxEntries int
+
+	// L1MaxBytes bounds the total size of the entries held in process memory (default: 16 MiB)
+	L1MaxBytes int64
+
+	// Channel is the Redis pub/sub channel carrying invalidations (default: cache:invalidate)
+	Channel string
+
+	// Meter records per-tier hit and miss counters and L1 eviction and size metrics;
+	// nil uses the global meter provider
+	Meter metric.Meter
+}
+
+// TieredCache serves reads from a small in-process L1 cache in front of a shared L2
+// cache such as Redis. Writes go to L2 first and then L1. Changes made through
+// Set, Delete, DeletePattern and Clear are published over Redis pub/sub so that
+// every instance drops the affected L1 entries; entries changed by other means are
+// refreshed from L2 once their short L1 TTL runs out.
+type TieredCache struct {
+	// l1 holds recently used entries in process memory
+	l1 *MemoryCache
+
+	// l2 is the shared cache every read falls through to
+	l2 ports.CacheService
+
+	// client publishes and receives invalidations; nil keeps invalidations local
+	client *redis.Client
+
+	// pubsub is the invalidation subscription; nil when client is nil
+	pubsub *redis.PubSub
+
+	// channel is the pub/sub channel carrying invalidations
+	channel string
+
+	// origin identifies this instance so it can skip its own invalidations
+	origin string
+
+	// l1TTL caps how long an entry is served from L1
+	l1TTL time.Duration
+
+	// hits counts reads answered by each tier
+	hits metric.Int64Counter
+
+	// misses counts reads each tier could not answer
+	misses metric.Int64Counter
+
+	// done is closed when the subscriber goroutine exits
+	done chan struct{}
+
+	// logger records invalidation failures
+	logger *zap.Logger
+}
+
+// invalidation is the pub/sub message announcing a change to the shared cache.
+type invalidation struct {
+	// Origin identifies the instance that made the change
+	Origin string `json:"origin"`
+
+	// Key is the changed key; empty when Pattern or All is set
+	Key string `json:"key,omitempty"`
+
+	// Pattern matches the deleted keys when values were deleted by pattern
+	Pattern string `json:"pattern,omitempty"`
+
+	// All reports that the whole cache was cleared
+	All bool `json:"all,omitempty"`
+}
+
+// NewTieredCache creates a two-tier cache and, when a Redis client is given,
+// subscribes to invalidations published by other instances.
+//
+// Parameters:
+//   - l2: Shared cache every read falls through to
+//   - client: Redis client for invalidation pub/sub (can be nil to keep invalidations local)
+//   - cfg: L1 size, TTL and invalidation channel settings
+//   - logger: Zap logger for cache operations
+//
+// Returns:
+//   - *TieredCache: Two-tier cache; call Close to stop the invalidation subscriber
+//   - error: Subscription error if Redis is unavailable
+func NewTieredCache(l2 ports.CacheService, client *redis.Client, cfg TieredConfig, logger *zap.Logger) (*TieredCache, error) {
+	if cfg.L1TTL <= 0 {
+		cfg.L1TTL = DefaultL1TTL
+	}
+
+	if cfg.L1MaxEntries <= 0 {
+		cfg.L1MaxEntries = DefaultL1MaxEntries
+	}
+
+	if cfg.L1MaxBytes <= 0 {
+		cfg.L1MaxBytes = DefaultL1MaxBytes
+	}
+
+	if cfg.Channel == "" {
+		cfg.Channel = DefaultInvalidationChannel
+	}
+
+	meter := cfg.Meter
+
+	if meter == nil {
+		meter = otel.Meter(instrumentationName)
+	}
+
+	// Instrument constructors return a usable no-op instrument alongside any error. The
+	// counters match those registered by observability, so both resolve to one instrument
+	hits, err := meter.Int64Counter(
+		"cache_hits_total",
+		metric.WithDescription("Total number of cache hits"),
+		metric.WithUnit("1"),
+	)
+
+	if err != nil {
+		logger.Warn("failed to create cache hit counter", zap.Error(err))
+	}
+
+	misses, err := meter.Int64Counter(
+		"cache_misses_total",
+		metric.WithDescription("Total number of cache misses"),
+		metric.WithUnit("1"),
+	)
+
+	if err != nil {
+		logger.Warn("failed to create cache miss counter", zap.Error(err))
+	}
+
+	t := &TieredCache{
+		l1:      newMemoryCache(MemoryConfig{MaxEntries: cfg.L1MaxEntries, MaxBytes: cfg.L1MaxBytes, Name: "l1", Meter: meter}, logger),
+		l2:      l2,
+		client:  client,
+		channel: cfg.Channel,
+		origin:  uuid.NewString(),
+		l1TTL:   cfg.L1TTL,
+		hits:    hits,
+		misses:  misses,
+		done:    make(chan struct{}),
+		logger:  logger,
+	}
+
+	if client == nil {
+		close(t.done)
+		return t, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	t.pubsub = client.Subscribe(ctx, cfg.Channel)
+
+	// Wait for the subscription so no invalidation published after this returns is missed
+	if _, err := t.pubsub.Receive(ctx); err != nil {
+		_ = t.pubsub.Close()
+		return nil, err
+	}
+
+	go t.subscribe()
+
+	return t, nil
+}
+
+// Get retrieves a value from L1, falling back to L2 on an L1 miss. Values read
+// from L2 are kept in L1 for the L1 TTL.
+//
+// Parameters:
+//   - ctx: Context for cancellation and tracing
+//   - key: Cache key to retrieve
+//
+// Returns:
+//   - []byte: Cached value if found
+//   - error: ErrCacheMiss if neither tier holds the key, or L2 error
+func (t *TieredCache) Get(ctx context.Context, key string) ([]byte, error) {
+	tracer := otel.Tracer("cache")
+	ctx, span := tracer.Start(ctx, "TieredCache.Get")
+
+	defer span.End()
+
+	span.SetAttributes(attribute.String("cache.key", key))
+
+	if value, ok := t.l1.lru.get(key); ok {
+		span.SetAttributes(attribute.Bool("cache.hit", true), attribute.String("cache.tier", "l1"))
+		t.hits.Add(ctx, 1, tierL1)
+
+		return value, nil
+	}
+
+	t.misses.Add(ctx, 1, tierL1)
+
+	// A write or invalidation of the key that arrives while L2 is read must not be
+	// undone by caching the old value
+	since := t.l1.lru.beginFill(key)
+	value, err := t.l2.Get(ctx, key)
+
+	if err != nil {
+		t.l1.lru.endFill(key, since, nil, 0)
+	}
+
+	if errors.Is(err, ErrCacheMiss) {
+		span.SetAttributes(attribute.Bool("cache.hit", false))
+		t.misses.Add(ctx, 1, tierL2)
+
+		return nil, err
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Bool("cache.hit", true), attribute.String("cache.tier", "l2"))
+	t.hits.Add(ctx, 1, tierL2)
+	t.l1.lru.endFill(key, since, value, t.l1TTL)
+
+	return value, nil
+}
+
+// Set stores a value in L2 and then L1, and tells other instances to drop the key
+// from their L1 so they read the new value from L2.
+//
+// Parameters:
+//   - ctx: Context for cancellation and tracing
+//   - key: Cache key
+//   - value: Data to cache
+//   - ttl: Time-to-live for the L2 entry; the L1 entry expires no later than the L1 TTL
+//
+// Returns:
+//   - error: L2 set error if operation fails
+func (t *TieredCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if err := t.l2.Set(ctx, key, value, ttl); err != nil {
+		t.l1.lru.delete(key)
+		return err
+	}
+
+	l1TTL := t.l1TTL
+
+	if ttl > 0 {
+		l1TTL = min(l1TTL, ttl)
+	}
+
+	t.l1.lru.set(key, value, l1TTL)
+	t.publish(ctx, invalidation{Key: key})
+
+	return nil
+}
+
+// Delete removes a value from both tiers on this instance and from L1 on every other instance.
+//
+// Parameters:
+//   - ctx: Context for cancellation and tracing
+//   - key: Cache key to delete
+//
+// Returns:
+//   - error: L2 deletion error if operation fails
+func (t *TieredCache) Delete(ctx context.Context, key string) error {
+	t.l1.lru.delete(key)
+
+	if err := t.l2.Delete(ctx, key); err != nil {
+		return err
+	}
+
+	t.publish(ctx, invalidation{Key: key})
+
+	return nil
+}
+
+// Clear empties L2 and L1 on this instance and L1 on every other instance.
+//
+// Parameters:
+//   - ctx: Context for cancellation and tracing
+//
+// Returns:
+//   - error: L2 clear error if operation fails
+func (t *TieredCache) Clear(ctx context.Context) error {
+	t.l1.lru.clear()
+
+	if err := t.l2.Clear(ctx); err != nil {
+		return err
+	}
+
+	t.publish(ctx, invalidation{All: true})
+
+	return nil
+}
+
+// DeletePattern removes the values matching a glob pattern from both tiers on this
+// instance and from L1 on every other instance.
+//
+// Parameters:
+//   - ctx: Context for cancellation and tracing
+//   - pattern: Glob pattern such as "weather:*"
+//
+// Returns:
+//   - int64: Number of keys deleted from L2
+//   - error: L2 deletion error if operation fails
+func (t *TieredCache) DeletePattern(ctx context.Context, pattern string) (int64, error) {
+	t.l1.lru.deleteMatching(pattern)
+
+	deleted, err := t.l2.DeletePattern(ctx, pattern)
+
+	if err != nil {
+		return deleted, err
+	}
+
+	t.publish(ctx, invalidation{Pattern: pattern})
+
+	return deleted, nil
+}
+
+// TryLock acquires a lock in L2, which must implement ports.LockService.
+//
+// Parameters:
+//   - ctx: Context for cancellation and tracing
+//   - key: Lock name
+//   - ttl: Maximum time the lock is held
+//
+// Returns:
+//   - string: Token to pass to Unlock
+//   - bool: Whether the lock was acquired
+//   - error: L2 lock error, or an error if L2 cannot take locks
+func (t *TieredCache) TryLock(ctx context.Context, key string, ttl time.Duration) (string, bool, error) {
+	locker, ok := t.l2.(ports.LockService)
+
+	if !ok {
+		return "", false, errNoLocking
+	}
+
+	return locker.TryLock(ctx, key, ttl)
+}
+
+// Unlock releases a lock acquired with TryLock.
+//
+// Parameters:
+//   - ctx: Context for cancellation and tracing
+//   - key: Lock name
+//   - token: Token returned by TryLock
+//
+// Returns:
+//   - error: L2 unlock error, or an error if L2 cannot take locks
+func (t *TieredCache) Unlock(ctx context.Context, key, token string) error {
+	locker, ok := t.l2.(ports.LockService)
+
+	if !ok {
+		return errNoLocking
+	}
+
+	return locker.Unlock(ctx, key, token)
+}
+
+// Close stops the invalidation subscriber. The L2 cache is left open.
+//
+// Returns:
+//   - error: Subscription close error
+func (t *TieredCache) Close() error {
+	if t.pubsub == nil {
+		return nil
+	}
+
+	err := t.pubsub.Close()
+	<-t.done
+
+	return err
+}
+
+// publish announces a change to the other instances. A failed publish is logged,
+// not returned, since the change itself succeeded and remote L1 entries expire
+// within the L1 TTL regardless.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - msg: Invalidation to publish; its origin is filled in
+func (t *TieredCache) publish(ctx context.Context, msg invalidation) {
+	if t.client == nil {
+		return
+	}
+
+	msg.Origin = t.origin
+	payload, err := json.Marshal(msg)
+
+	if err != nil {
+		t.logger.Warn("failed to encode cache invalidation", zap.Error(err))
+		return
+	}
+
+	if err := t.client.Publish(ctx, t.channel, payload).Err(); err != nil {
+		t.logger.Warn("failed to publish cache invalidation", zap.String("key", msg.Key), zap.Error(err))
+	}
+}
+
+// subscribe applies invalidations published by other instances until the
+// subscription is closed.
+func (t *TieredCache) subscribe() {
+	defer close(t.done)
+
+	for msg := range t.pubsub.Channel() {
+		t.invalidate(msg.Payload)
+	}
+}
+
+// invalidate drops the L1 entries named by an invalidation message. Messages
+// published by this instance are skipped, since it already applied them.
+//
+// Parameters:
+//   - payload: Encoded invalidation message
+func (t *TieredCache) invalidate(payload string) {
+	var msg invalidation
+
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		t.logger.Warn("discarding undecodable cache invalidation", zap.Error(err))
+		return
+	}
+
+	if msg.Origin == t.origin {
+		return
+	}
+
+	switch {
+	case msg.All:
+		t.l1.lru.clear()
+	case msg.Pattern != "":
+		t.l1.lru.deleteMatching(msg.Pattern)
+	default:
+		t.l1.lru.delete(msg.Key)
+	}
+}
@@ -0,0 +1,232 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.uber.org/zap"
+)
+
+// collectTierCounts reads the tiered cache counters from a manual reader, keyed by
+// counter name and tier, such as "cache_hits_total/l1".
+func collectTierCounts(t *testing.T, reader *sdkmetric.ManualReader) map[string]int64 {
+	t.Helper()
+
+	var metrics metricdata.ResourceMetrics
+
+	assert.NoError(t, reader.Collect(context.Background(), &metrics))
+
+	counts := map[string]int64{}
+
+	for _, scope := range metrics.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if sum, ok := m.Data.(metricdata.Sum[int64]); ok {
+				for _, point := range sum.DataPoints {
+					tier, _ := point.Attributes.Value(attribute.Key("tier"))
+					counts[m.Name+"/"+tier.AsString()] += point.Value
+				}
+			}
+		}
+	}
+
+	return counts
+}
+
+// newTestTieredCache creates a tiered cache over a memory L2 without pub/sub.
+func newTestTieredCache(t *testing.T, cfg TieredConfig) (*TieredCache, *MemoryCache) {
+	t.Helper()
+
+	logger := zap.NewNop()
+	l2 := newMemoryCache(MemoryConfig{}, logger)
+	tiered, err := NewTieredCache(l2, nil, cfg, logger)
+
+	assert.NoError(t, err)
+
+	return tiered, l2
+}
+
+// TestTieredCache tests reads, writes and invalidation across the two tiers.
+func TestTieredCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reads through to L2 and then serves from L1", func(t *testing.T) {
+		reader := sdkmetric.NewManualReader()
+		meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+		tiered, l2 := newTestTieredCache(t, TieredConfig{Meter: meter})
+
+		assert.NoError(t, l2.Set(ctx, "key", []byte("value"), time.Minute))
+
+		for range 3 {
+			value, err := tiered.Get(ctx, "key")
+
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("value"), value)
+		}
+
+		_, err := tiered.Get(ctx, "missing")
+		assert.ErrorIs(t, err, ErrCacheMiss)
+
+		counts := collectTierCounts(t, reader)
+
+		assert.Equal(t, int64(1), counts["cache_hits_total/l2"])
+		assert.Equal(t, int64(2), counts["cache_hits_total/l1"])
+		assert.Equal(t, int64(2), counts["cache_misses_total/l1"])
+		assert.Equal(t, int64(1), counts["cache_misses_total/l2"])
+	})
+
+	t.Run("L1 entries expire after the L1 TTL", func(t *testing.T) {
+		tiered, l2 := newTestTieredCache(t, TieredConfig{L1TTL: 20 * time.Millisecond})
+
+		assert.NoError(t, tiered.Set(ctx, "key", []byte("old"), time.Minute))
+		assert.NoError(t, l2.Set(ctx, "key", []byte("new"), time.Minute))
+
+		value, _ := tiered.Get(ctx, "key")
+		assert.Equal(t, []byte("old"), value, "L1 serves its copy until it expires")
+
+		time.Sleep(30 * time.Millisecond)
+
+		value, _ = tiered.Get(ctx, "key")
+		assert.Equal(t, []byte("new"), value)
+	})
+
+	t.Run("L1 is bounded and evicts the least recently used entry", func(t *testing.T) {
+		tiered, l2 := newTestTieredCache(t, TieredConfig{L1MaxEntries: 2})
+
+		assert.NoError(t, tiered.Set(ctx, "a", []byte("1"), time.Minute))
+		assert.NoError(t, tiered.Set(ctx, "b", []byte("2"), time.Minute))
+		_, _ = tiered.Get(ctx, "a")
+		assert.NoError(t, tiered.Set(ctx, "c", []byte("3"), time.Minute))
+
+		_, inL1 := tiered.l1.lru.get("b")
+		assert.False(t, inL1, "the least recently used entry is evicted")
+
+		_, inL1 = tiered.l1.lru.get("a")
+		assert.True(t, inL1)
+
+		value, err := l2.Get(ctx, "b")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("2"), value, "eviction leaves L2 alone")
+	})
+
+	t.Run("delete and clear empty both tiers", func(t *testing.T) {
+		tiered, l2 := newTestTieredCache(t, TieredConfig{})
+
+		assert.NoError(t, tiered.Set(ctx, "a", []byte("1"), time.Minute))
+		assert.NoError(t, tiered.Set(ctx, "b", []byte("2"), time.Minute))
+
+		assert.NoError(t, tiered.Delete(ctx, "a"))
+
+		_, err := tiered.Get(ctx, "a")
+		assert.ErrorIs(t, err, ErrCacheMiss)
+
+		assert.NoError(t, tiered.Clear(ctx))
+
+		_, err = tiered.Get(ctx, "b")
+		assert.ErrorIs(t, err, ErrCacheMiss)
+
+		_, err = l2.Get(ctx, "b")
+		assert.ErrorIs(t, err, ErrCacheMiss)
+	})
+
+	t.Run("delete by pattern empties matching keys in both tiers", func(t *testing.T) {
+		tiered, l2 := newTestTieredCache(t, TieredConfig{})
+
+		assert.NoError(t, tiered.Set(ctx, "weather:a", []byte("1"), time.Minute))
+		assert.NoError(t, tiered.Set(ctx, "weather:b", []byte("2"), time.Minute))
+		assert.NoError(t, tiered.Set(ctx, "forecast:a", []byte("3"), time.Minute))
+
+		deleted, err := tiered.DeletePattern(ctx, "weather:*")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), deleted)
+
+		_, err = tiered.Get(ctx, "weather:a")
+		assert.ErrorIs(t, err, ErrCacheMiss)
+
+		_, err = l2.Get(ctx, "weather:b")
+		assert.ErrorIs(t, err, ErrCacheMiss)
+
+		value, err := tiered.Get(ctx, "forecast:a")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("3"), value)
+	})
+
+	t.Run("invalidations from other instances drop L1 entries", func(t *testing.T) {
+		tiered, _ := newTestTieredCache(t, TieredConfig{})
+
+		assert.NoError(t, tiered.Set(ctx, "a", []byte("1"), time.Minute))
+		assert.NoError(t, tiered.Set(ctx, "b", []byte("2"), time.Minute))
+
+		own, _ := json.Marshal(invalidation{Origin: tiered.origin, Key: "a"})
+		tiered.invalidate(string(own))
+
+		_, inL1 := tiered.l1.lru.get("a")
+		assert.True(t, inL1, "an instance skips its own invalidations")
+
+		remote, _ := json.Marshal(invalidation{Origin: "other", Key: "a"})
+		tiered.invalidate(string(remote))
+
+		_, inL1 = tiered.l1.lru.get("a")
+		assert.False(t, inL1)
+
+		assert.NoError(t, tiered.Set(ctx, "weather:a", []byte("3"), time.Minute))
+
+		pattern, _ := json.Marshal(invalidation{Origin: "other", Pattern: "weather:*"})
+		tiered.invalidate(string(pattern))
+
+		_, inL1 = tiered.l1.lru.get("weather:a")
+		assert.False(t, inL1)
+
+		all, _ := json.Marshal(invalidation{Origin: "other", All: true})
+		tiered.invalidate(string(all))
+
+		_, inL1 = tiered.l1.lru.get("b")
+		assert.False(t, inL1)
+	})
+
+	t.Run("a read racing an invalidation does not repopulate L1", func(t *testing.T) {
+		tiered, _ := newTestTieredCache(t, TieredConfig{})
+		since := tiered.l1.lru.beginFill("key")
+
+		tiered.l1.lru.delete("key")
+		tiered.l1.lru.endFill("key", since, []byte("old"), time.Minute)
+
+		_, inL1 := tiered.l1.lru.get("key")
+		assert.False(t, inL1)
+
+		since = tiered.l1.lru.beginFill("key")
+		tiered.l1.lru.clear()
+		tiered.l1.lru.endFill("key", since, []byte("old"), time.Minute)
+
+		_, inL1 = tiered.l1.lru.get("key")
+		assert.False(t, inL1, "a clear cancels every fill in progress")
+	})
+
+	t.Run("writes to other keys do not cancel a fill", func(t *testing.T) {
+		tiered, _ := newTestTieredCache(t, TieredConfig{})
+		since := tiered.l1.lru.beginFill("key")
+
+		tiered.l1.lru.set("other", []byte("value"), time.Minute)
+		tiered.l1.lru.delete("another")
+		tiered.l1.lru.endFill("key", since, []byte("fresh"), time.Minute)
+
+		value, inL1 := tiered.l1.lru.get("key")
+		assert.True(t, inL1)
+		assert.Equal(t, []byte("fresh"), value)
+		assert.Empty(t, tiered.l1.lru.fills, "finished fills are forgotten")
+		assert.Empty(t, tiered.l1.lru.written)
+	})
+
+	t.Run("locking requires an L2 that supports it", func(t *testing.T) {
+		tiered, _ := newTestTieredCache(t, TieredConfig{})
+
+		_, _, err := tiered.TryLock(ctx, "lock:key", time.Second)
+		assert.ErrorIs(t, err, errNoLocking)
+	})
+}
@@ -26,13 +26,14 @@ type CircuitBreakerWrapper struct {
 
 // Config defines circuit breaker behavior and thresholds.
 // It configures when the breaker opens, how long it stays open,
-// and callback functions for state changes.
+// which errors count as failures, and callback functions for state changes.
 type Config struct {
 	Name          string
 	MaxRequests   uint32
 	Interval      time.Duration
 	Timeout       time.Duration
 	ReadyToTrip   func(counts gobreaker.Counts) bool
+	IsSuccessful  func(err error) bool
 	OnStateChange func(name string, from gobreaker.State, to gobreaker.State)
 }
 
@@ -46,11 +47,12 @@ type Config struct {
 //   - *CircuitBreakerWrapper: Configured circuit breaker instance
 func NewCircuitBreaker(cfg Config, logger *zap.Logger) *CircuitBreakerWrapper {
 	settings := gobreaker.Settings{
-		Name:        cfg.Name,
-		MaxRequests: cfg.MaxRequests,
-		Interval:    cfg.Interval,
-		Timeout:     cfg.Timeout,
-		ReadyToTrip: cfg.ReadyToTrip,
+		Name:         cfg.Name,
+		MaxRequests:  cfg.MaxRequests,
+		Interval:     cfg.Interval,
+		Timeout:      cfg.Timeout,
+		ReadyToTrip:  cfg.ReadyToTrip,
+		IsSuccessful: cfg.IsSuccessful,
 		OnStateChange: func(name string, from gobreaker.State, to gobreaker.State) {
 			logger.Info("circuit breaker state changed",
 				zap.String("name", name),
@@ -0,0 +1,118 @@
+-- Record forecast details alongside each weather request
+-- Adds wind, precipitation probability, humidity, dewpoint, icon and
+-- detailed forecast columns, and extends sp_log_weather_request to populate them
+
+-- Add detail columns to weather_requests (all nullable; providers may omit any of them)
+ALTER TABLE weather_requests
+    ADD COLUMN IF NOT EXISTS wind_speed_min DECIMAL(6, 2),
+    ADD COLUMN IF NOT EXISTS wind_speed_max DECIMAL(6, 2),
+    ADD COLUMN IF NOT EXISTS wind_speed_unit VARCHAR(8),
+    ADD COLUMN IF NOT EXISTS wind_direction VARCHAR(3),
+    ADD COLUMN IF NOT EXISTS precipitation_probability DECIMAL(5, 2),
+    ADD COLUMN IF NOT EXISTS relative_humidity DECIMAL(5, 2),
+    ADD COLUMN IF NOT EXISTS dewpoint DECIMAL(5, 2),
+    ADD COLUMN IF NOT EXISTS dewpoint_unit VARCHAR(1),
+    ADD COLUMN IF NOT EXISTS icon TEXT,
+    ADD COLUMN IF NOT EXISTS detailed_forecast TEXT;
+
+-- =====================================================================
+-- Procedure: sp_log_weather_request
+-- Purpose: Records weather request details for analytics
+-- The previous nine-parameter version is dropped so only one signature exists
+-- =====================================================================
+DROP PROCEDURE IF EXISTS sp_log_weather_request(
+    VARCHAR, DECIMAL, DECIMAL, DECIMAL, VARCHAR, TEXT, VARCHAR, INT, BOOLEAN
+);
+
+CREATE OR REPLACE PROCEDURE sp_log_weather_request(
+    IN p_request_id VARCHAR(36),
+    IN p_latitude DECIMAL(10, 6),
+    IN p_longitude DECIMAL(10, 6),
+    IN p_temperature DECIMAL(5, 2),
+    IN p_temperature_unit VARCHAR(1),
+    IN p_forecast TEXT,
+    IN p_category VARCHAR(20),
+    IN p_response_time_ms INT,
+    IN p_cache_hit BOOLEAN,
+    IN p_wind_speed_min DECIMAL(6, 2),
+    IN p_wind_speed_max DECIMAL(6, 2),
+    IN p_wind_speed_unit VARCHAR(8),
+    IN p_wind_direction VARCHAR(3),
+    IN p_precipitation_probability DECIMAL(5, 2),
+    IN p_relative_humidity DECIMAL(5, 2),
+    IN p_dewpoint DECIMAL(5, 2),
+    IN p_dewpoint_unit VARCHAR(1),
+    IN p_icon TEXT,
+    IN p_detailed_forecast TEXT
+)
+LANGUAGE plpgsql
+AS $$
+BEGIN
+    INSERT INTO weather_requests (
+        request_id,
+        latitude,
+        longitude,
+        temperature,
+        temperature_unit,
+        forecast,
+        category,
+        response_time_ms,
+        cache_hit,
+        wind_speed_min,
+        wind_speed_max,
+        wind_speed_unit,
+        wind_direction,
+        precipitation_probability,
+        relative_humidity,
+        dewpoint,
+        dewpoint_unit,
+        icon,
+        detailed_forecast
+    ) VALUES (
+        p_request_id,
+        p_latitude,
+        p_longitude,
+        p_temperature,
+        p_temperature_unit,
+        p_forecast,
+        p_category,
+        p_response_time_ms,
+        p_cache_hit,
+        p_wind_speed_min,
+        p_wind_speed_max,
+        p_wind_speed_unit,
+        p_wind_direction,
+        p_precipitation_probability,
+        p_relative_humidity,
+        p_dewpoint,
+        p_dewpoint_unit,
+        p_icon,
+        p_detailed_forecast
+    );
+EXCEPTION
+    WHEN unique_violation THEN
+        -- If request_id already exists, update the record
+        UPDATE weather_requests 
+        SET 
+            latitude = p_latitude,
+            longitude = p_longitude,
+            temperature = p_temperature,
+            temperature_unit = p_temperature_unit,
+            forecast = p_forecast,
+            category = p_category,
+            response_time_ms = p_response_time_ms,
+            cache_hit = p_cache_hit,
+            wind_speed_min = p_wind_speed_min,
+            wind_speed_max = p_wind_speed_max,
+            wind_speed_unit = p_wind_speed_unit,
+            wind_direction = p_wind_direction,
+            precipitation_probability = p_precipitation_probability,
+            relative_humidity = p_relative_humidity,
+            dewpoint = p_dewpoint,
+            dewpoint_unit = p_dewpoint_unit,
+            icon = p_icon,
+            detailed_forecast = p_detailed_forecast,
+            timestamp = CURRENT_TIMESTAMP
+        WHERE request_id = p_request_id;
+END;
+$$;
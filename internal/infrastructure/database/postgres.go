@@ -168,15 +168,25 @@ func (p *PostgresDB) LogAudit(ctx context.Context, log AuditLog) error {
 }
 
 type WeatherRequest struct {
-	RequestID       string
-	Latitude        float64
-	Longitude       float64
-	Temperature     float64
-	TemperatureUnit string
-	Forecast        string
-	Category        string
-	ResponseTimeMs  int
-	CacheHit        bool
+	RequestID                string
+	Latitude                 float64
+	Longitude                float64
+	Temperature              float64
+	TemperatureUnit          string
+	Forecast                 string
+	Category                 string
+	ResponseTimeMs           int
+	CacheHit                 bool
+	WindSpeedMin             *float64
+	WindSpeedMax             *float64
+	WindSpeedUnit            string
+	WindDirection            string
+	PrecipitationProbability *float64
+	RelativeHumidity         *float64
+	Dewpoint                 *float64
+	DewpointUnit             string
+	Icon                     string
+	DetailedForecast         string
 }
 
 // LogWeatherRequest records details about weather API requests for analytics.
@@ -200,7 +210,7 @@ func (p *PostgresDB) LogWeatherRequest(ctx context.Context, req WeatherRequest)
 	)
 
 	// Call the stored procedure
-	query := `CALL sp_log_weather_request($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	query := `CALL sp_log_weather_request($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)`
 
 	start := time.Now()
 	_, err := p.db.ExecContext(ctx, query,
@@ -213,6 +223,16 @@ func (p *PostgresDB) LogWeatherRequest(ctx context.Context, req WeatherRequest)
 		req.Category,
 		req.ResponseTimeMs,
 		req.CacheHit,
+		req.WindSpeedMin,
+		req.WindSpeedMax,
+		nullString(req.WindSpeedUnit),
+		nullString(req.WindDirection),
+		req.PrecipitationProbability,
+		req.RelativeHumidity,
+		req.Dewpoint,
+		nullString(req.DewpointUnit),
+		nullString(req.Icon),
+		nullString(req.DetailedForecast),
 	)
 
 	duration := time.Since(start)
@@ -276,6 +296,64 @@ func (p *PostgresDB) GetRequestStats(ctx context.Context, since time.Time) (map[
 	return result, nil
 }
 
+// PopularLocation is a frequently requested location returned by fn_get_popular_locations.
+type PopularLocation struct {
+	Latitude     float64
+	Longitude    float64
+	RequestCount int64
+}
+
+// GetPopularLocations retrieves the most frequently requested locations.
+//
+// Parameters:
+//   - ctx: Context for query cancellation
+//   - limit: Maximum number of locations to return
+//   - since: Start time for the request window
+//
+// Returns:
+//   - []PopularLocation: Locations ordered by request count, most requested first
+//   - error: Query execution error or scan error
+func (p *PostgresDB) GetPopularLocations(ctx context.Context, limit int, since time.Time) ([]PopularLocation, error) {
+	tracer := otel.Tracer("database")
+	ctx, span := tracer.Start(ctx, "GetPopularLocations")
+
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("limit", limit))
+
+	// Call the stored function
+	query := `SELECT latitude, longitude, request_count FROM fn_get_popular_locations($1, $2)`
+
+	rows, err := p.db.QueryContext(ctx, query, limit, since)
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var locations []PopularLocation
+
+	for rows.Next() {
+		var l PopularLocation
+
+		if err := rows.Scan(&l.Latitude, &l.Longitude, &l.RequestCount); err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+
+		locations = append(locations, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return locations, nil
+}
+
 // Close closes the database connection pool.
 //
 // Returns:
@@ -291,3 +369,14 @@ func (p *PostgresDB) Close() error {
 func (p *PostgresDB) Ping() error {
 	return p.db.Ping()
 }
+
+// nullString converts an empty string to a SQL NULL.
+//
+// Parameters:
+//   - s: String value to convert
+//
+// Returns:
+//   - sql.NullString: Valid only when s is non-empty
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
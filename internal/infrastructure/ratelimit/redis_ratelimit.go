@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
@@ -36,6 +37,35 @@ func NewRedisRateLimiter(client *redis.Client, logger *zap.Logger) ports.RateLim
 	}
 }
 
+// allowScript atomically trims the sliding window and records n entries if
+// they fit under the limit. Each entry gets a unique member so that requests
+// arriving within the same millisecond are all counted.
+const allowScript = `
+        local key = KEYS[1]
+        local limit = tonumber(ARGV[1])
+        local window = tonumber(ARGV[2])
+        local now = tonumber(ARGV[3])
+        local n = tonumber(ARGV[4])
+        local token = ARGV[5]
+        
+        -- Remove expired entries
+        redis.call('ZREMRANGEBYSCORE', key, 0, now - window)
+        
+        -- Count current requests
+        local current = redis.call('ZCARD', key)
+        
+        if current + n <= limit then
+            -- Add one entry per unit of cost
+            for i = 1, n do
+                redis.call('ZADD', key, now, token .. ':' .. i)
+            end
+            redis.call('PEXPIRE', key, window)
+            return 1
+        else
+            return 0
+        end
+    `
+
 // Allow checks if a request is allowed under the rate limit.
 //
 // Parameters:
@@ -48,43 +78,39 @@ func NewRedisRateLimiter(client *redis.Client, logger *zap.Logger) ports.RateLim
 //   - bool: true if request is allowed, false if rate limit exceeded
 //   - error: Redis error if operation fails
 func (r *RedisRateLimiter) Allow(ctx context.Context, identifier string, limit int, window time.Duration) (bool, error) {
+	return r.AllowN(ctx, identifier, 1, limit, window)
+}
+
+// AllowN checks if a request costing n units is allowed under the rate limit.
+//
+// Parameters:
+//   - ctx: Context for cancellation and tracing
+//   - identifier: Client identifier (usually IP address)
+//   - n: Number of units the request consumes
+//   - limit: Maximum units allowed in window
+//   - window: Time window for rate limiting
+//
+// Returns:
+//   - bool: true if all n units were consumed, false if they would exceed the limit
+//   - error: Redis error if operation fails
+func (r *RedisRateLimiter) AllowN(ctx context.Context, identifier string, n, limit int, window time.Duration) (bool, error) {
 	tracer := otel.Tracer("ratelimit")
-	ctx, span := tracer.Start(ctx, "RateLimit.Allow")
+	ctx, span := tracer.Start(ctx, "RateLimit.AllowN")
 
 	defer span.End()
 
 	span.SetAttributes(
 		attribute.String("ratelimit.identifier", identifier),
+		attribute.Int("ratelimit.cost", n),
 		attribute.Int("ratelimit.limit", limit),
 		attribute.String("ratelimit.window", window.String()),
 	)
 
 	key := "ratelimit:" + identifier
+	now := time.Now().UnixMilli()
+	token := uuid.NewString()
 
-	luaScript := `
-        local key = KEYS[1]
-        local limit = tonumber(ARGV[1])
-        local window = tonumber(ARGV[2])
-        local now = tonumber(ARGV[3])
-        
-        -- Remove expired entries
-        redis.call('ZREMRANGEBYSCORE', key, 0, now - window)
-        
-        -- Count current requests
-        local current = redis.call('ZCARD', key)
-        
-        if current < limit then
-            -- Add current request
-            redis.call('ZADD', key, now, now)
-            redis.call('EXPIRE', key, window)
-            return 1
-        else
-            return 0
-        end
-    `
-
-	now := time.Now().Unix()
-	result, err := r.client.Eval(ctx, luaScript, []string{key}, limit, int(window.Seconds()), now).Result()
+	result, err := r.client.Eval(ctx, allowScript, []string{key}, limit, window.Milliseconds(), now, n, token).Result()
 
 	if err != nil {
 		span.RecordError(err)
@@ -102,6 +128,7 @@ func (r *RedisRateLimiter) Allow(ctx context.Context, identifier string, limit i
 	if !allowed {
 		r.logger.Debug("rate limit exceeded",
 			zap.String("identifier", identifier),
+			zap.Int("cost", n),
 			zap.Int("limit", limit))
 	}
 
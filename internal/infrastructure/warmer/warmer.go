@@ -0,0 +1,329 @@
+// Package warmer keeps the weather cache warm for frequently requested locations.
+// It periodically refreshes the cached forecasts of the most requested locations, or
+// of a configured static list, before they expire so that traffic spikes are served
+// from cache.
+package warmer
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// instrumentationName names the meter used when Config.Meter is not set.
+const instrumentationName = "github.com/sean-rowe/weather-service/internal/infrastructure/warmer"
+
+// Warmer defaults applied when Config leaves a setting at zero.
+const (
+	defaultInterval             = 4 * time.Minute
+	defaultTopN                 = 50
+	defaultLookback             = 7 * 24 * time.Hour
+	defaultMaxRequestsPerMinute = 60
+)
+
+// Config holds tunable settings for the cache warmer.
+// Zero values are replaced with sensible defaults.
+type Config struct {
+	// Interval is the time between warmer runs (default: 4m)
+	Interval time.Duration
+
+	// Jitter is the largest random delay added to each interval so that replicas
+	// do not warm in lockstep (default: a tenth of Interval; negative disables it)
+	Jitter time.Duration
+
+	// TopN is how many of the most requested locations are warmed (default: 50)
+	TopN int
+
+	// Lookback is how far back requests count toward a location's popularity (default: 7 days)
+	Lookback time.Duration
+
+	// Locations, when set, are warmed instead of the most requested locations
+	Locations []domain.Coordinates
+
+	// MaxRequestsPerMinute caps the upstream requests the warmer makes per minute (default: 60)
+	MaxRequestsPerMinute int
+
+	// Meter records warmer metrics; nil uses the global meter provider
+	Meter metric.Meter
+}
+
+// Warmer periodically refreshes cached weather for popular locations.
+type Warmer struct {
+	// service refreshes the cache entries of each location
+	service ports.WeatherService
+
+	// db supplies the most requested locations; nil when only static locations are warmed
+	db ports.DatabaseRepository
+
+	// cfg holds the warmer settings with defaults applied
+	cfg Config
+
+	// runs counts warmer runs
+	runs metric.Int64Counter
+
+	// refreshes counts cache entries refreshed from upstream
+	refreshes metric.Int64Counter
+
+	// failures counts failed refreshes and failed location lookups
+	failures metric.Int64Counter
+
+	// logger records warmer runs and failures
+	logger *zap.Logger
+}
+
+// New creates a cache warmer.
+//
+// Parameters:
+//   - service: WeatherService whose cache entries are refreshed
+//   - db: DatabaseRepository supplying popular locations (can be nil when cfg.Locations is set)
+//   - cfg: Warmer settings such as interval and upstream request cap
+//   - logger: Zap logger for runs and failures
+//
+// Returns:
+//   - *Warmer: Configured warmer; call Run to start it
+func New(service ports.WeatherService, db ports.DatabaseRepository, cfg Config, logger *zap.Logger) *Warmer {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultInterval
+	}
+
+	if cfg.Jitter < 0 {
+		cfg.Jitter = 0
+	} else if cfg.Jitter == 0 {
+		cfg.Jitter = cfg.Interval / 10
+	}
+
+	if cfg.TopN <= 0 {
+		cfg.TopN = defaultTopN
+	}
+
+	if cfg.Lookback <= 0 {
+		cfg.Lookback = defaultLookback
+	}
+
+	if cfg.MaxRequestsPerMinute <= 0 {
+		cfg.MaxRequestsPerMinute = defaultMaxRequestsPerMinute
+	}
+
+	meter := cfg.Meter
+
+	if meter == nil {
+		meter = otel.Meter(instrumentationName)
+	}
+
+	// Instrument constructors return a usable no-op instrument alongside any error
+	runs, err := meter.Int64Counter(
+		"cache_warmer_runs_total",
+		metric.WithDescription("Total number of cache warmer runs"),
+		metric.WithUnit("1"),
+	)
+
+	if err != nil {
+		logger.Warn("failed to create warmer run counter", zap.Error(err))
+	}
+
+	refreshes, err := meter.Int64Counter(
+		"cache_warmer_refreshes_total",
+		metric.WithDescription("Total number of cache entries refreshed by the cache warmer"),
+		metric.WithUnit("1"),
+	)
+
+	if err != nil {
+		logger.Warn("failed to create warmer refresh counter", zap.Error(err))
+	}
+
+	failures, err := meter.Int64Counter(
+		"cache_warmer_failures_total",
+		metric.WithDescription("Total number of failed cache warmer refreshes and location lookups"),
+		metric.WithUnit("1"),
+	)
+
+	if err != nil {
+		logger.Warn("failed to create warmer failure counter", zap.Error(err))
+	}
+
+	return &Warmer{
+		service:   service,
+		db:        db,
+		cfg:       cfg,
+		runs:      runs,
+		refreshes: refreshes,
+		failures:  failures,
+		logger:    logger,
+	}
+}
+
+// Run warms the cache shortly after it is called and then once per interval,
+// each run delayed by a random jitter. It blocks until ctx is done.
+//
+// Parameters:
+//   - ctx: Context whose cancellation stops the warmer
+func (w *Warmer) Run(ctx context.Context) {
+	w.logger.Info("cache warmer started",
+		zap.Duration("interval", w.cfg.Interval),
+		zap.Int("max_requests_per_minute", w.cfg.MaxRequestsPerMinute),
+	)
+
+	delay := w.jitter()
+
+	for {
+		if !sleep(ctx, delay) {
+			w.logger.Info("cache warmer stopped")
+			return
+		}
+
+		w.warm(ctx)
+
+		delay = w.cfg.Interval + w.jitter()
+	}
+}
+
+// warm refreshes the cache entries of every location once. Upstream requests are
+// spread out so that the run stays under the configured per-minute cap.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+func (w *Warmer) warm(ctx context.Context) {
+	start := time.Now()
+
+	w.runs.Add(ctx, 1)
+
+	locations, err := w.locations(ctx)
+
+	if err != nil {
+		w.failures.Add(ctx, 1)
+		w.logger.Warn("cache warmer failed to load locations", zap.Error(err))
+
+		return
+	}
+
+	// Entries that would go stale before the next run are refreshed now
+	horizon := w.cfg.Interval + w.cfg.Jitter
+	spacing := time.Minute / time.Duration(w.cfg.MaxRequestsPerMinute)
+	refreshed, failed := 0, 0
+
+	for _, coords := range locations {
+		n, err := w.service.WarmLocation(ctx, coords, horizon)
+		requests := n
+
+		w.refreshes.Add(ctx, int64(n))
+		refreshed += n
+
+		if err != nil {
+			count := countErrors(err)
+			requests += count
+			failed += count
+
+			w.failures.Add(ctx, int64(count))
+			w.logger.Debug("cache warmer failed to refresh location",
+				zap.Float64("latitude", coords.Latitude),
+				zap.Float64("longitude", coords.Longitude),
+				zap.Error(err),
+			)
+		}
+
+		if !sleep(ctx, time.Duration(requests)*spacing) {
+			return
+		}
+	}
+
+	w.logger.Info("cache warmer run completed",
+		zap.Int("locations", len(locations)),
+		zap.Int("refreshed", refreshed),
+		zap.Int("failed", failed),
+		zap.Duration("duration", time.Since(start)),
+	)
+}
+
+// locations returns the locations to warm: the configured static list if there is
+// one, otherwise the most requested locations from the database.
+//
+// Parameters:
+//   - ctx: Context for the database query
+//
+// Returns:
+//   - []domain.Coordinates: Locations to warm, possibly empty
+//   - error: Database query error
+func (w *Warmer) locations(ctx context.Context) ([]domain.Coordinates, error) {
+	if len(w.cfg.Locations) > 0 {
+		return w.cfg.Locations, nil
+	}
+
+	if w.db == nil {
+		return nil, nil
+	}
+
+	popular, err := w.db.GetPopularLocations(ctx, w.cfg.TopN, time.Now().Add(-w.cfg.Lookback))
+
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]domain.Coordinates, 0, len(popular))
+
+	for _, p := range popular {
+		locations = append(locations, p.Coordinates)
+	}
+
+	return locations, nil
+}
+
+// jitter returns a random delay between zero and the configured jitter.
+//
+// Returns:
+//   - time.Duration: Random delay
+func (w *Warmer) jitter() time.Duration {
+	if w.cfg.Jitter <= 0 {
+		return 0
+	}
+
+	return rand.N(w.cfg.Jitter + 1)
+}
+
+// countErrors returns how many errors err holds, counting each error joined by errors.Join.
+//
+// Parameters:
+//   - err: Error returned by WarmLocation
+//
+// Returns:
+//   - int: Number of failed refreshes
+func countErrors(err error) int {
+	var joined interface{ Unwrap() []error }
+
+	if errors.As(err, &joined) {
+		return len(joined.Unwrap())
+	}
+
+	return 1
+}
+
+// sleep waits for d or until ctx is done.
+//
+// Parameters:
+//   - ctx: Context whose cancellation ends the wait early
+//   - d: How long to wait
+//
+// Returns:
+//   - bool: False if ctx was done before d elapsed
+func sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,225 @@
+package warmer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.uber.org/zap"
+
+	"github.com/sean-rowe/weather-service/internal/core/domain"
+	"github.com/sean-rowe/weather-service/internal/core/ports"
+)
+
+// stubService is a WeatherService whose WarmLocation records its calls.
+// Its other methods are not used by the warmer.
+type stubService struct {
+	ports.WeatherService
+
+	// mu guards warmed
+	mu sync.Mutex
+
+	// warmed records the locations passed to WarmLocation
+	warmed []domain.Coordinates
+
+	// horizon records the last horizon passed to WarmLocation
+	horizon time.Duration
+
+	// refreshed is the count WarmLocation returns
+	refreshed int
+
+	// err is the error WarmLocation returns
+	err error
+}
+
+// WarmLocation records the location and returns the configured result.
+func (s *stubService) WarmLocation(_ context.Context, coords domain.Coordinates, horizon time.Duration) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.warmed = append(s.warmed, coords)
+	s.horizon = horizon
+
+	return s.refreshed, s.err
+}
+
+// calls returns the locations warmed so far.
+func (s *stubService) calls() []domain.Coordinates {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]domain.Coordinates(nil), s.warmed...)
+}
+
+// stubDatabase is a DatabaseRepository that serves a fixed list of popular locations.
+type stubDatabase struct {
+	ports.DatabaseRepository
+
+	// popular is returned by GetPopularLocations
+	popular []ports.PopularLocation
+
+	// err is returned by GetPopularLocations
+	err error
+
+	// limit and since record the last query
+	limit int
+	since time.Time
+}
+
+// GetPopularLocations records the query and returns the configured locations.
+func (d *stubDatabase) GetPopularLocations(_ context.Context, limit int, since time.Time) ([]ports.PopularLocation, error) {
+	d.limit = limit
+	d.since = since
+
+	return d.popular, d.err
+}
+
+// collectCounters reads the warmer counters from a manual reader.
+func collectCounters(t *testing.T, reader *sdkmetric.ManualReader) map[string]int64 {
+	t.Helper()
+
+	var metrics metricdata.ResourceMetrics
+
+	assert.NoError(t, reader.Collect(context.Background(), &metrics))
+
+	counts := map[string]int64{}
+
+	for _, scope := range metrics.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if sum, ok := m.Data.(metricdata.Sum[int64]); ok {
+				for _, point := range sum.DataPoints {
+					counts[m.Name] += point.Value
+				}
+			}
+		}
+	}
+
+	return counts
+}
+
+// TestWarmer_Warm tests a single warmer run.
+func TestWarmer_Warm(t *testing.T) {
+	logger := zap.NewNop()
+	nyc := domain.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	la := domain.Coordinates{Latitude: 34.0522, Longitude: -118.2437}
+
+	t.Run("warms the most requested locations", func(t *testing.T) {
+		reader := sdkmetric.NewManualReader()
+		meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+		service := &stubService{refreshed: 3}
+		db := &stubDatabase{popular: []ports.PopularLocation{
+			{Coordinates: nyc, RequestCount: 120},
+			{Coordinates: la, RequestCount: 80},
+		}}
+		w := New(service, db, Config{
+			Interval:             time.Minute,
+			Jitter:               -1,
+			TopN:                 2,
+			Lookback:             24 * time.Hour,
+			MaxRequestsPerMinute: 60000,
+			Meter:                meter,
+		}, logger)
+
+		w.warm(context.Background())
+
+		assert.Equal(t, []domain.Coordinates{nyc, la}, service.calls())
+		assert.Equal(t, time.Minute, service.horizon, "entries that go stale before the next run are refreshed")
+		assert.Equal(t, 2, db.limit)
+		assert.WithinDuration(t, time.Now().Add(-24*time.Hour), db.since, time.Second)
+
+		counts := collectCounters(t, reader)
+
+		assert.Equal(t, int64(1), counts["cache_warmer_runs_total"])
+		assert.Equal(t, int64(6), counts["cache_warmer_refreshes_total"])
+		assert.Equal(t, int64(0), counts["cache_warmer_failures_total"])
+	})
+
+	t.Run("static locations replace the database", func(t *testing.T) {
+		service := &stubService{}
+		db := &stubDatabase{err: errors.New("database should not be queried")}
+		w := New(service, db, Config{Locations: []domain.Coordinates{la}, MaxRequestsPerMinute: 60000}, logger)
+
+		w.warm(context.Background())
+
+		assert.Equal(t, []domain.Coordinates{la}, service.calls())
+		assert.Zero(t, db.limit)
+	})
+
+	t.Run("failures are counted", func(t *testing.T) {
+		reader := sdkmetric.NewManualReader()
+		meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+		service := &stubService{
+			refreshed: 1,
+			err:       errors.Join(errors.New("forecast failed"), errors.New("hourly failed")),
+		}
+		w := New(service, nil, Config{Locations: []domain.Coordinates{nyc}, MaxRequestsPerMinute: 60000, Meter: meter}, logger)
+
+		w.warm(context.Background())
+
+		counts := collectCounters(t, reader)
+
+		assert.Equal(t, int64(1), counts["cache_warmer_refreshes_total"])
+		assert.Equal(t, int64(2), counts["cache_warmer_failures_total"])
+	})
+
+	t.Run("a failed location lookup is counted", func(t *testing.T) {
+		reader := sdkmetric.NewManualReader()
+		meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+		service := &stubService{}
+		db := &stubDatabase{err: errors.New("connection refused")}
+		w := New(service, db, Config{Meter: meter}, logger)
+
+		w.warm(context.Background())
+
+		assert.Empty(t, service.calls())
+		assert.Equal(t, int64(1), collectCounters(t, reader)["cache_warmer_failures_total"])
+	})
+
+	t.Run("upstream requests are spread under the per-minute cap", func(t *testing.T) {
+		service := &stubService{refreshed: 1}
+		w := New(service, nil, Config{
+			Locations:            []domain.Coordinates{nyc, la, nyc},
+			MaxRequestsPerMinute: 1200,
+		}, logger)
+
+		start := time.Now()
+		w.warm(context.Background())
+
+		assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond, "three requests at 1200/min take at least 150ms")
+	})
+}
+
+// TestWarmer_Run tests the warmer schedule.
+func TestWarmer_Run(t *testing.T) {
+	service := &stubService{}
+	w := New(service, nil, Config{
+		Interval:             20 * time.Millisecond,
+		Jitter:               5 * time.Millisecond,
+		Locations:            []domain.Coordinates{{Latitude: 40.7128, Longitude: -74.0060}},
+		MaxRequestsPerMinute: 60000,
+	}, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		w.Run(ctx)
+		close(done)
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("warmer did not stop when its context was cancelled")
+	}
+
+	assert.GreaterOrEqual(t, len(service.calls()), 2, "the warmer runs once per interval")
+}
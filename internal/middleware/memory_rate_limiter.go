@@ -53,6 +53,22 @@ func NewMemoryRateLimiter(logger *zap.Logger) ports.RateLimitService {
 //   - bool: true if request is allowed, false if rate limit exceeded
 //   - error: Always nil for in-memory implementation
 func (rl *MemoryRateLimiter) Allow(ctx context.Context, identifier string, limit int, window time.Duration) (bool, error) {
+	return rl.AllowN(ctx, identifier, 1, limit, window)
+}
+
+// AllowN checks if a request costing n units from the given identifier is allowed under the rate limit.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - identifier: Client identifier (usually IP address)
+//   - n: Number of units the request consumes
+//   - limit: Maximum units allowed in window
+//   - window: Time window for rate limiting
+//
+// Returns:
+//   - bool: true if all n units were consumed, false if they would exceed the limit
+//   - error: Always nil for in-memory implementation
+func (rl *MemoryRateLimiter) AllowN(ctx context.Context, identifier string, n, limit int, window time.Duration) (bool, error) {
 	// Check if context is canceled
 	select {
 	case <-ctx.Done():
@@ -97,11 +113,14 @@ func (rl *MemoryRateLimiter) Allow(ctx context.Context, identifier string, limit
 
 	client.requests = validRequests
 
-	if len(client.requests) >= limit {
+	if len(client.requests)+n > limit {
 		return false, nil
 	}
 
-	client.requests = append(client.requests, now)
+	for i := 0; i < n; i++ {
+		client.requests = append(client.requests, now)
+	}
+
 	return true, nil
 }
 
@@ -35,11 +35,44 @@ func NewRateLimitMiddleware(rateLimiter ports.RateLimitService, limit int, windo
 	}
 }
 
+// CostFunc returns the number of rate-limit units a request consumes.
+type CostFunc func(r *http.Request) int
+
 // Middleware returns an HTTP handler that enforces rate limiting.
+// Each request consumes one unit.
 func (rl *RateLimitMiddleware) Middleware(next http.Handler) http.Handler {
+	return rl.charge(next, func(*http.Request) int { return 1 })
+}
+
+// Weighted returns middleware that charges additional units for expensive
+// requests such as batches. It is applied to individual routes behind
+// Middleware, which has already charged the first unit, so only cost-1
+// further units are consumed and the request costs cost units in total.
+//
+// Parameters:
+//   - cost: Function returning the total cost of a request
+//
+// Returns:
+//   - func(http.Handler) http.Handler: Route middleware enforcing the extra cost
+func (rl *RateLimitMiddleware) Weighted(cost CostFunc) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return rl.charge(next, func(r *http.Request) int { return cost(r) - 1 })
+	}
+}
+
+// charge consumes cost(r) units for the client and rejects the request with
+// 429 if the limit would be exceeded. Requests costing nothing pass through.
+func (rl *RateLimitMiddleware) charge(next http.Handler, cost CostFunc) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := cost(r)
+
+		if n <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
 		identifier := GetClientIP(r)
-		allowed, err := rl.rateLimiter.Allow(r.Context(), identifier, rl.limit, rl.window)
+		allowed, err := rl.rateLimiter.AllowN(r.Context(), identifier, n, rl.limit, rl.window)
 
 		if err != nil {
 			rl.logger.Error("rate limiter error",
@@ -54,6 +87,7 @@ func (rl *RateLimitMiddleware) Middleware(next http.Handler) http.Handler {
 		if !allowed {
 			rl.logger.Warn("rate limit exceeded",
 				zap.String("client_ip", identifier),
+				zap.Int("cost", n),
 				zap.Int("limit", rl.limit),
 				zap.Duration("window", rl.window))
 